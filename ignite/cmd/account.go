@@ -41,11 +41,15 @@ chain.
 
 	c.AddCommand(
 		NewAccountCreate(),
+		NewAccountCreateMultisig(),
 		NewAccountDelete(),
 		NewAccountShow(),
 		NewAccountList(),
 		NewAccountImport(),
 		NewAccountExport(),
+		NewAccountWatch(),
+		NewAccountImportKeyring(),
+		NewAccountExportKeyring(),
 	)
 
 	return c
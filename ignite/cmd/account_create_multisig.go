@@ -0,0 +1,55 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const flagThreshold = "threshold"
+
+func NewAccountCreateMultisig() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "create-multisig [name] [account]...",
+		Short: "Create a multisig account from existing accounts",
+		Long: `Create a multisig account from the public keys of existing accounts.
+
+The transactions of a multisig account must be signed by a number of its
+accounts equal to the threshold. The accounts can be local, watch-only or
+multisig accounts:
+
+	ignite account create-multisig team alice bob carol --threshold 2
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: accountCreateMultisigHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().Int(flagThreshold, 1, "number of signatures required to sign the transactions of the account")
+
+	return c
+}
+
+func accountCreateMultisigHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name         = args[0]
+		threshold, _ = cmd.Flags().GetInt(flagThreshold)
+	)
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithHome(getKeyringDir(cmd)),
+	)
+	if err != nil {
+		return errors.Errorf("unable to create registry: %w", err)
+	}
+
+	acc, err := ca.CreateMultisig(name, threshold, args[1:])
+	if err != nil {
+		return errors.Errorf("unable to create multisig account: %w", err)
+	}
+
+	cmd.Printf("Multisig account %q created with a threshold of %d.\n\n", name, threshold)
+	return printAccounts(cmd, acc)
+}
@@ -0,0 +1,68 @@
+package ignitecmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+func NewAccountExportKeyring() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export-keyring",
+		Short: "Export all the accounts to a file encrypted with a passphrase",
+		Long: `Export all the accounts of the keyring to a single file encrypted with a
+passphrase, for example to move the accounts to another machine with the
+"import-keyring" command.
+
+The private keys of the local accounts are exported, as well as the public keys
+of the watch-only and multisig accounts. Ledger accounts are not exported.
+`,
+		Args: cobra.NoArgs,
+		RunE: accountExportKeyringHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetAccountExport())
+	c.Flags().String(flagPath, "./keyring", "path to export the accounts")
+
+	return c
+}
+
+func accountExportKeyringHandler(cmd *cobra.Command, _ []string) error {
+	passphrase, err := getPassphrase(cmd)
+	if err != nil {
+		return err
+	}
+	const minPassLength = 8
+	if len(passphrase) < minPassLength {
+		return errors.Errorf("passphrase must be at least %d characters", minPassLength)
+	}
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithHome(getKeyringDir(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	armored, accounts, err := ca.ExportKeyring(passphrase)
+	if err != nil {
+		return err
+	}
+
+	path, err := filepath.Abs(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, []byte(armored), 0o600); err != nil {
+		return err
+	}
+
+	cmd.Printf("%d accounts exported to file: %s\n", len(accounts), path)
+	return nil
+}
@@ -0,0 +1,56 @@
+package ignitecmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+)
+
+func NewAccountImportKeyring() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "import-keyring [path]",
+		Short: "Import the accounts of a file exported with export-keyring",
+		Long: `Import the accounts of a file exported with the "export-keyring" command.
+
+None of the accounts are imported when an account with the same name already
+exists in the keyring.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: accountImportKeyringHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().AddFlagSet(flagSetAccountImport())
+
+	return c
+}
+
+func accountImportKeyringHandler(cmd *cobra.Command, args []string) error {
+	armored, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := getPassphrase(cmd)
+	if err != nil {
+		return err
+	}
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithHome(getKeyringDir(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	accounts, err := ca.ImportKeyring(string(armored), passphrase)
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%d accounts imported.\n\n", len(accounts))
+	return printAccounts(cmd, accounts...)
+}
@@ -0,0 +1,124 @@
+package ignitecmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	flagPubKey  = "pubkey"
+	flagAddress = "address"
+	flagNode    = "node"
+)
+
+func NewAccountWatch() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "watch [name]",
+		Short: "Import a watch-only account from its public key or address",
+		Long: `Import a watch-only account, which doesn't have a private key and can't sign
+transactions, for example to use it in a multisig account.
+
+The account is imported from its JSON encoded public key, like the ones displayed
+by the "keys show --pubkey" command of the chains:
+
+	ignite account watch alice --pubkey '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8Ks..."}'
+
+Or from its address, in which case the public key is queried from a node of a
+chain where the account has already signed a transaction:
+
+	ignite account watch alice --address cosmos1... --node https://rpc.cosmos.network:443
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: accountWatchHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().String(flagPubKey, "", "JSON encoded public key of the account")
+	c.Flags().String(flagAddress, "", "address of the account")
+	c.Flags().String(flagNode, "http://localhost:26657", "node to query the public key of the address")
+
+	return c
+}
+
+func accountWatchHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name       = args[0]
+		pubKey, _  = cmd.Flags().GetString(flagPubKey)
+		address, _ = cmd.Flags().GetString(flagAddress)
+		node, _    = cmd.Flags().GetString(flagNode)
+	)
+
+	if (pubKey == "") == (address == "") {
+		return errors.Errorf("either --%s or --%s is required", flagPubKey, flagAddress)
+	}
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithHome(getKeyringDir(cmd)),
+	)
+	if err != nil {
+		return errors.Errorf("unable to create registry: %w", err)
+	}
+
+	var pk cryptotypes.PubKey
+	if pubKey != "" {
+		pk, err = ca.ParsePubKey(pubKey)
+	} else {
+		pk, err = queryAccountPubKey(cmd.Context(), node, address)
+	}
+	if err != nil {
+		return err
+	}
+
+	acc, err := ca.ImportPubKey(name, pk)
+	if err != nil {
+		return errors.Errorf("unable to import account: %w", err)
+	}
+
+	cmd.Printf("Watch-only account %q imported.\n\n", name)
+	return printAccounts(cmd, acc)
+}
+
+// queryAccountPubKey queries the public key of an account from a node.
+func queryAccountPubKey(ctx context.Context, node, address string) (cryptotypes.PubKey, error) {
+	prefix, err := cosmosutil.GetAddressPrefix(address)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := cosmosclient.New(
+		ctx,
+		cosmosclient.WithNodeAddress(node),
+		cosmosclient.WithAddressPrefix(prefix),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringMemory),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCtx := client.Context()
+	res, err := authtypes.NewQueryClient(clientCtx).AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{
+		Address: address,
+	})
+	if err != nil {
+		return nil, errors.Errorf("unable to query account %s: %w", address, err)
+	}
+	if res.Info == nil || res.Info.PubKey == nil {
+		return nil, errors.Errorf("the public key of account %s is unknown until it signs a transaction", address)
+	}
+
+	var pk cryptotypes.PubKey
+	if err := clientCtx.InterfaceRegistry.UnpackAny(res.Info.PubKey, &pk); err != nil {
+		return nil, err
+	}
+	return pk, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
 	cliuimodel "github.com/ignite/cli/v29/ignite/pkg/cliui/model"
 	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/services/chain"
 )
 
 const (
@@ -38,15 +39,26 @@ type Context interface {
 	SetContext(context.Context)
 }
 
+// ChainServeOption configures the chain serve UI model.
+type ChainServeOption func(*ChainServe)
+
+// WithStateInspector enables the panel displaying the state of the served chain.
+func WithStateInspector(inspector StateInspector) ChainServeOption {
+	return func(m *ChainServe) {
+		m.inspector = inspector
+	}
+}
+
 // NewChainServe returns a new UI model for the chain serve command.
-func NewChainServe(mCtx Context, bus events.Provider, cmd tea.Cmd) ChainServe {
+func NewChainServe(mCtx Context, bus events.Provider, cmd tea.Cmd, options ...ChainServeOption) ChainServe {
 	// Initialize a context and cancel function to stop execution
 	ctx, quit := context.WithCancel(mCtx.Context())
 
 	// Update the context to allow stopping by using the 'q' key
 	mCtx.SetContext(ctx)
 
-	return ChainServe{
+	m := ChainServe{
+		ctx:          ctx,
 		cmd:          cmd,
 		quit:         quit,
 		startModel:   cliuimodel.NewStatusEvents(bus, maxStatusEvents),
@@ -54,10 +66,15 @@ func NewChainServe(mCtx Context, bus events.Provider, cmd tea.Cmd) ChainServe {
 		rebuildModel: cliuimodel.NewStatusEvents(bus, maxStatusEvents),
 		quitModel:    cliuimodel.NewEvents(bus),
 	}
+	for _, apply := range options {
+		apply(&m)
+	}
+	return m
 }
 
 // ChainServe defines a UI model for the chain serve command.
 type ChainServe struct {
+	ctx  context.Context
 	cmd  tea.Cmd
 	quit context.CancelFunc
 
@@ -70,6 +87,14 @@ type ChainServe struct {
 	runModel     cliuimodel.Events
 	rebuildModel cliuimodel.StatusEvents
 	quitModel    cliuimodel.Events
+
+	// Chain state panel
+	inspector  StateInspector
+	showState  bool // True when the chain state panel is displayed
+	inspecting bool // True while the chain state is periodically inspected
+	chainState chain.ChainState
+	stateErr   error
+	selectedTx int
 }
 
 // Init is the first function that will be called.
@@ -95,6 +120,8 @@ func (m ChainServe) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.processKeyMsg(msg)
 	case cliuimodel.EventMsg:
 		return m.processEventMsg(msg)
+	case ChainStateMsg:
+		return m.processChainStateMsg(msg)
 	default:
 		return m.updateCurrentModel(msg)
 	}
@@ -156,11 +183,47 @@ func (m ChainServe) processKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if checkQuitKeyMsg(msg) {
 		// Cancel the context to signal stop
 		m.quit()
+		return m, nil
+	}
+
+	if m.inspector == nil || m.state == stateChainServeQuitting {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "i":
+		m.showState = !m.showState
+		if m.showState && !m.inspecting {
+			m.inspecting = true
+			return m, inspectState(m.ctx, m.inspector, 0)
+		}
+	case "up", "k":
+		if m.showState && m.selectedTx > 0 {
+			m.selectedTx--
+		}
+	case "down", "j":
+		if m.showState && m.selectedTx < len(m.chainState.Txs)-1 {
+			m.selectedTx++
+		}
 	}
 
 	return m, nil
 }
 
+func (m ChainServe) processChainStateMsg(msg ChainStateMsg) (tea.Model, tea.Cmd) {
+	m.chainState, m.stateErr = msg.State, msg.Error
+	if m.selectedTx >= len(m.chainState.Txs) {
+		m.selectedTx = max(len(m.chainState.Txs)-1, 0)
+	}
+
+	// Stop inspecting the state when the panel is hidden
+	if !m.showState || m.state == stateChainServeQuitting {
+		m.inspecting = false
+		return m, nil
+	}
+	return m, inspectState(m.ctx, m.inspector, stateRefreshInterval)
+}
+
 func (m ChainServe) processEventMsg(msg cliuimodel.EventMsg) (tea.Model, tea.Cmd) {
 	// When an error event is received it means there is an issue with
 	// the blockchain app's source code that the user must fix.
@@ -212,7 +275,19 @@ func (m ChainServe) processEventMsg(msg cliuimodel.EventMsg) (tea.Model, tea.Cmd
 }
 
 func (m ChainServe) renderActions() string {
-	return fmt.Sprintf("\n%s\n", msgStopServe)
+	var actions strings.Builder
+
+	actions.WriteString("\n")
+	if m.inspector != nil && m.state == stateChainServeRunning {
+		if m.showState {
+			fmt.Fprintf(&actions, "%s\n", msgHideState)
+		} else {
+			fmt.Fprintf(&actions, "%s\n", msgShowState)
+		}
+	}
+	fmt.Fprintf(&actions, "%s\n", msgStopServe)
+
+	return actions.String()
 }
 
 func (m ChainServe) renderStartView() string {
@@ -230,6 +305,8 @@ func (m ChainServe) renderRunView() string {
 
 	if m.broken {
 		fmt.Fprintf(&view, "\n%s\n", msgWaitingFix)
+	} else if m.showState {
+		fmt.Fprintf(&view, "\n%s", renderStatePanel(m.chainState, m.stateErr, m.selectedTx))
 	}
 
 	return view.String()
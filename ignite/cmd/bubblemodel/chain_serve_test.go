@@ -1,6 +1,7 @@
 package cmdmodel_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -14,8 +15,10 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
 	cliuimodel "github.com/ignite/cli/v29/ignite/pkg/cliui/model"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/services/chain"
 )
 
 var chainServeActions = colors.Faint("Press the 'q' key to stop serve")
@@ -171,3 +174,68 @@ func TestChainServeRebuildView(t *testing.T) {
 	// Assert
 	require.Equal(t, want, view)
 }
+
+type stateInspector struct {
+	state chain.ChainState
+}
+
+func (i stateInspector) Inspect(context.Context) (chain.ChainState, error) {
+	return i.state, nil
+}
+
+func TestChainServeStateView(t *testing.T) {
+	// Arrange
+	var (
+		model tea.Model
+		cmd   tea.Cmd
+	)
+
+	state := chain.ChainState{
+		Height:      42,
+		MempoolSize: 3,
+		Txs: []chain.StateTx{
+			{
+				Height: 41,
+				Hash:   "ABCDEF0123456789",
+				Msgs:   []chain.StateMsg{{Type: "/mars.mars.v1.MsgCreatePost", JSON: `{"title":"foo"}`}},
+			},
+		},
+		Stores: []chain.StateStore{{Name: "mars", StoreSize: cosmosclient.StoreSize{Keys: 7, Bytes: 2048}}},
+	}
+	model = cmdmodel.NewChainServe(
+		testdata.ModelContext{},
+		testdata.DummyEventsProvider{},
+		testdata.FooCmd,
+		cmdmodel.WithStateInspector(stateInspector{state}),
+	)
+
+	// Arrange: Update model to display the run view
+	model, _ = model.Update(cliuimodel.EventMsg{
+		Event: events.New("Run", events.ProgressFinish()),
+	})
+	require.Contains(t, model.View(), "Press the 'i' key to show the chain state")
+
+	// Act: Show the chain state panel
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	require.NotNil(t, cmd)
+	model, cmd = model.Update(cmd())
+	view := model.View()
+
+	// Assert
+	require.NotNil(t, cmd, "the state must be inspected again while the panel is displayed")
+	require.Contains(t, view, "Chain state")
+	require.Contains(t, view, "Height: 42")
+	require.Contains(t, view, "Mempool: 3 tx(s)")
+	require.Contains(t, view, "ABCDEF01")
+	require.Contains(t, view, "/mars.mars.v1.MsgCreatePost")
+	require.Contains(t, view, `{"title":"foo"}`)
+	require.Contains(t, view, "2.0 kB")
+
+	// Act: Hide the chain state panel
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	model, cmd = model.Update(cmd())
+
+	// Assert
+	require.Nil(t, cmd, "the state must not be inspected while the panel is hidden")
+	require.NotContains(t, model.View(), "Chain state")
+}
@@ -0,0 +1,158 @@
+package cmdmodel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dustin/go-humanize"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+// stateRefreshInterval is the interval between two inspections of the chain state.
+const stateRefreshInterval = time.Second
+
+var (
+	msgShowState = colors.Faint("Press the 'i' key to show the chain state")
+	msgHideState = colors.Faint("Press the 'i' key to hide the chain state and the up/down keys to select a transaction")
+)
+
+// StateInspector defines an interface to inspect the state of the served chain.
+type StateInspector interface {
+	// Inspect returns the current state of the served chain.
+	Inspect(context.Context) (chain.ChainState, error)
+}
+
+// ChainStateMsg defines a message with the inspected state of the served chain.
+type ChainStateMsg struct {
+	State chain.ChainState
+	Error error
+}
+
+// inspectState returns a command that inspects the chain state after a delay.
+func inspectState(ctx context.Context, inspector StateInspector, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		state, err := inspector.Inspect(ctx)
+		return ChainStateMsg{State: state, Error: err}
+	})
+}
+
+// renderStatePanel renders the state of the served chain with the selected transaction.
+func renderStatePanel(state chain.ChainState, err error, selectedTx int) string {
+	var view strings.Builder
+
+	view.WriteString(colors.Info("Chain state") + "\n\n")
+
+	if state.Height == 0 {
+		if err != nil {
+			fmt.Fprintf(&view, "%s\n", colors.Faint(err.Error()))
+		} else {
+			fmt.Fprintf(&view, "%s\n", colors.Faint("Waiting for the first block..."))
+		}
+		return view.String()
+	}
+
+	fmt.Fprintf(
+		&view,
+		"Height: %d   Block time: %s %s   Mempool: %d tx(s)\n",
+		state.Height,
+		state.BlockTime.Local().Format(time.TimeOnly),
+		colors.Faint(fmt.Sprintf("(%s)", state.BlockInterval.Round(time.Millisecond))),
+		state.MempoolSize,
+	)
+	if err != nil {
+		fmt.Fprintf(&view, "%s\n", colors.Faint(err.Error()))
+	}
+
+	view.WriteString("\nRecent transactions:\n")
+	if len(state.Txs) == 0 {
+		fmt.Fprintf(&view, "  %s\n", colors.Faint("No transactions"))
+	}
+	for i, tx := range state.Txs {
+		cursor := " "
+		if i == selectedTx {
+			cursor = ">"
+		}
+
+		status := icons.OK
+		if tx.Code != 0 {
+			status = icons.NotOK
+		}
+
+		types := make([]string, len(tx.Msgs))
+		for j, msg := range tx.Msgs {
+			types[j] = msg.Type
+		}
+
+		fmt.Fprintf(
+			&view,
+			"%s %s %s %s %s\n",
+			cursor,
+			colors.Faint(fmt.Sprintf("%d", tx.Height)),
+			shortHash(tx.Hash),
+			status,
+			strings.Join(types, ", "),
+		)
+	}
+
+	if selectedTx < len(state.Txs) {
+		view.WriteString(renderStateTx(state.Txs[selectedTx]))
+	}
+
+	if len(state.Stores) > 0 {
+		view.WriteString("\nStores:\n")
+		for _, s := range state.Stores {
+			fmt.Fprintf(
+				&view,
+				"  %-16s %8d keys %10s\n",
+				s.Name,
+				s.Keys,
+				humanize.Bytes(uint64(s.Bytes)), //nolint:gosec // sizes are positive
+			)
+		}
+	}
+
+	return view.String()
+}
+
+// renderStateTx renders the decoded messages and the events of a transaction.
+func renderStateTx(tx chain.StateTx) string {
+	var view strings.Builder
+
+	fmt.Fprintf(&view, "\n%s %s\n", colors.Info("Transaction"), tx.Hash)
+	if tx.Log != "" {
+		fmt.Fprintf(&view, "  %s\n", colors.Error(tx.Log))
+	}
+
+	for _, msg := range tx.Msgs {
+		fmt.Fprintf(&view, "  %s\n", msg.Type)
+		if msg.JSON != "" {
+			fmt.Fprintf(&view, "    %s\n", colors.Faint(msg.JSON))
+		}
+	}
+
+	if len(tx.Events) > 0 {
+		view.WriteString("  Events:\n")
+	}
+	for _, e := range tx.Events {
+		attrs := make([]string, len(e.Attributes))
+		for i, a := range e.Attributes {
+			attrs[i] = fmt.Sprintf("%s=%s", a.Key, a.Value)
+		}
+		fmt.Fprintf(&view, "    %s %s\n", e.Type, colors.Faint(strings.Join(attrs, " ")))
+	}
+
+	return view.String()
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 8 {
+		return hash[:8]
+	}
+	return hash
+}
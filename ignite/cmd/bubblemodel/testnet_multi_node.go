@@ -7,12 +7,17 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/faultproxy"
+	"github.com/ignite/cli/v29/ignite/pkg/tendermintrpc"
 	"github.com/ignite/cli/v29/ignite/services/chain"
 )
 
@@ -27,6 +32,25 @@ const (
 	Running
 )
 
+const (
+	// Keys selecting the fault injected in the next node selected by its number.
+	keyPartition = "x"
+	keyLatency   = "l"
+	keyDrop      = "d"
+	keyPause     = "p"
+
+	// keyHeal removes the network faults of all the nodes.
+	keyHeal = "h"
+
+	// Faults injected in a node with the keys.
+	defaultFaultLatency  = 500 * time.Millisecond
+	defaultFaultJitter   = 200 * time.Millisecond
+	defaultFaultDropRate = 0.1
+
+	// heightPollInterval is the interval between two checks of the testnet block height.
+	heightPollInterval = time.Second
+)
+
 // Make sure MultiNode implements tea.Model interface.
 var _ tea.Model = MultiNode{}
 
@@ -40,6 +64,31 @@ type MultiNode struct {
 	pids         []int      // Store the PIDs of the running processes
 	numNodes     int        // Number of nodes
 	logs         [][]string // Store logs for each node
+
+	network   *chain.FaultNetwork // Proxies injecting the network faults, nil when disabled
+	scenario  []chain.FaultStep   // Fault steps not yet reached by the testnet
+	paused    []bool              // Nodes with a suspended process
+	faultKey  string              // Fault selected for the next node number
+	faultLogs []string            // Last injected faults
+	height    int64               // Latest block height of the testnet
+}
+
+// MultiNodeOption configures the multi-node model.
+type MultiNodeOption func(*MultiNode)
+
+// WithFaultNetwork enables the fault injection through the network proxies.
+func WithFaultNetwork(network *chain.FaultNetwork) MultiNodeOption {
+	return func(m *MultiNode) {
+		m.network = network
+	}
+}
+
+// WithFaultScenario injects the faults of the scenario when the testnet reaches their block heights.
+// The scenario requires a fault network.
+func WithFaultScenario(scenario chain.FaultScenario) MultiNodeOption {
+	return func(m *MultiNode) {
+		m.scenario = scenario.Faults
+	}
 }
 
 // ToggleNodeMsg is a structure used to pass messages
@@ -57,6 +106,11 @@ type UpdateStatusMsg struct {
 // UpdateLogsMsg is for continuously updating the chain logs in the View.
 type UpdateLogsMsg struct{}
 
+// UpdateHeightMsg defines a message that updates the latest block height of the testnet.
+type UpdateHeightMsg struct {
+	height int64
+}
+
 // UpdateDeemon returns a command that sends an UpdateLogsMsg.
 // This command is intended to continuously refresh the logs displayed in the user interface.
 func UpdateDeemon() tea.Cmd {
@@ -66,12 +120,12 @@ func UpdateDeemon() tea.Cmd {
 }
 
 // NewModel initializes the model.
-func NewModel(ctx context.Context, chainname string, args chain.MultiNodeArgs) (MultiNode, error) {
+func NewModel(ctx context.Context, chainname string, args chain.MultiNodeArgs, options ...MultiNodeOption) (MultiNode, error) {
 	numNodes, err := strconv.Atoi(args.NumValidator)
 	if err != nil {
 		return MultiNode{}, err
 	}
-	return MultiNode{
+	m := MultiNode{
 		ctx:          ctx,
 		appd:         chainname + "d",
 		args:         args,
@@ -79,14 +133,50 @@ func NewModel(ctx context.Context, chainname string, args chain.MultiNodeArgs) (
 		pids:         make([]int, numNodes),
 		numNodes:     numNodes,
 		logs:         make([][]string, numNodes), // Initialize logs for each node
-	}, nil
+		paused:       make([]bool, numNodes),
+	}
+	for _, apply := range options {
+		apply(&m)
+	}
+	return m, nil
 }
 
 // Init implements the Init method of the tea.Model interface.
 func (m MultiNode) Init() tea.Cmd {
+	if m.network != nil {
+		return PollHeight(m)
+	}
 	return nil
 }
 
+// PollHeight returns a command that sends the latest block height of the running nodes.
+// The nodes to poll are selected when the command is created, so the model is not read
+// from the goroutine of the tick.
+func PollHeight(m MultiNode) tea.Cmd {
+	var (
+		ctx   = m.ctx
+		ports []uint
+	)
+	for i, port := range m.args.ListPorts {
+		if m.nodeStatuses[i] == Running && !m.paused[i] {
+			ports = append(ports, port)
+		}
+	}
+
+	return tea.Tick(heightPollInterval, func(time.Time) tea.Msg {
+		var height int64
+		for _, port := range ports {
+			ctx, cancel := context.WithTimeout(ctx, heightPollInterval)
+			h, err := tendermintrpc.New(fmt.Sprintf("http://127.0.0.1:%d", port)).LatestBlockHeight(ctx)
+			cancel()
+			if err == nil && h > height {
+				height = h
+			}
+		}
+		return UpdateHeightMsg{height: height}
+	})
+}
+
 // ToggleNode toggles the state of a node.
 func ToggleNode(nodeIdx int) tea.Cmd {
 	return func() tea.Msg {
@@ -106,7 +196,11 @@ func RunNode(nodeIdx int, start bool, m MultiNode) tea.Cmd {
 		if start {
 			nodeHome := filepath.Join(args.OutputDir, args.NodeDirPrefix+strconv.Itoa(nodeIdx))
 			// Create the command to run in the background as a daemon
-			cmd := exec.Command(appd, "start", "--home", nodeHome)
+			startArgs := []string{"start", "--home", nodeHome}
+			if m.network != nil {
+				startArgs = append(startArgs, m.network.StartFlags(nodeIdx)...)
+			}
+			cmd := exec.Command(appd, startArgs...)
 
 			// Start the process as a daemon
 			cmd.SysProcAttr = &syscall.SysProcAttr{
@@ -126,6 +220,7 @@ func RunNode(nodeIdx int, start bool, m MultiNode) tea.Cmd {
 			}
 
 			*pid = cmd.Process.Pid // Store the PID
+			m.paused[nodeIdx] = false
 
 			// Create an errgroup with context
 			g, gCtx := errgroup.WithContext(m.ctx)
@@ -163,6 +258,9 @@ func RunNode(nodeIdx int, start bool, m MultiNode) tea.Cmd {
 					if err != nil {
 						fmt.Printf("Failed to stop node %d: %v\n", nodeIdx+1, err)
 					} else {
+						if m.paused[nodeIdx] {
+							_ = syscall.Kill(-*pid, syscall.SIGCONT)
+						}
 						*pid = 0 // Reset PID after stopping
 					}
 				}
@@ -178,6 +276,11 @@ func RunNode(nodeIdx int, start bool, m MultiNode) tea.Cmd {
 			if err != nil {
 				fmt.Printf("Failed to stop node %d: %v\n", nodeIdx+1, err)
 			} else {
+				// A suspended process must be resumed to handle the termination
+				if m.paused[nodeIdx] {
+					_ = syscall.Kill(-*pid, syscall.SIGCONT)
+					m.paused[nodeIdx] = false
+				}
 				*pid = 0 // Reset PID after stopping
 			}
 		}
@@ -185,6 +288,116 @@ func RunNode(nodeIdx int, start bool, m MultiNode) tea.Cmd {
 	}
 }
 
+// PauseNode suspends or resumes the process of a running node.
+func (m *MultiNode) PauseNode(nodeIdx int, pause bool) error {
+	pid := m.pids[nodeIdx]
+	if m.nodeStatuses[nodeIdx] != Running || pid == 0 {
+		return errors.Errorf("node %d is not running", nodeIdx+1)
+	}
+	if m.paused[nodeIdx] == pause {
+		return nil
+	}
+
+	sig := syscall.SIGCONT
+	if pause {
+		sig = syscall.SIGSTOP
+	}
+	if err := syscall.Kill(-pid, sig); err != nil {
+		return err
+	}
+	m.paused[nodeIdx] = pause
+	return nil
+}
+
+// ApplyFault injects the fault of a scenario step.
+func (m *MultiNode) ApplyFault(step chain.FaultStep) error {
+	switch step.Action {
+	case chain.FaultPause, chain.FaultResume:
+		for _, node := range step.Nodes {
+			if err := m.PauseNode(node-1, step.Action == chain.FaultPause); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return m.network.Apply(step)
+	}
+}
+
+// ToggleFault injects the fault selected by the key in a node, or removes it if already injected.
+func (m *MultiNode) ToggleFault(key string, nodeIdx int) error {
+	step := chain.FaultStep{Nodes: []int{nodeIdx + 1}}
+	f := m.network.Faults(nodeIdx)
+
+	switch key {
+	case keyPartition:
+		step.Action = chain.FaultPartition
+		if f.Partitioned {
+			step.Action = chain.FaultHeal
+		}
+	case keyLatency:
+		step.Action, step.Latency, step.Jitter = chain.FaultLatency, defaultFaultLatency, defaultFaultJitter
+		if f.Latency > 0 {
+			step.Action = chain.FaultHeal
+		}
+	case keyDrop:
+		step.Action, step.Rate = chain.FaultDrop, defaultFaultDropRate
+		if f.DropRate > 0 {
+			step.Action = chain.FaultHeal
+		}
+	case keyPause:
+		step.Action = chain.FaultPause
+		if m.paused[nodeIdx] {
+			step.Action = chain.FaultResume
+		}
+	}
+
+	// Healing a node removes only the toggled fault
+	if step.Action == chain.FaultHeal {
+		switch key {
+		case keyPartition:
+			f.Partitioned = false
+		case keyLatency:
+			f.Latency, f.Jitter = 0, 0
+		case keyDrop:
+			f.DropRate = 0
+		}
+		m.network.SetFaults(nodeIdx, f)
+		m.logFault(fmt.Sprintf("heal node %d (%s)", nodeIdx+1, faultName(key)))
+		return nil
+	}
+
+	if err := m.ApplyFault(step); err != nil {
+		return err
+	}
+	m.logFault(step.String())
+	return nil
+}
+
+func faultName(key string) string {
+	switch key {
+	case keyPartition:
+		return string(chain.FaultPartition)
+	case keyLatency:
+		return string(chain.FaultLatency)
+	case keyDrop:
+		return string(chain.FaultDrop)
+	default:
+		return string(chain.FaultPause)
+	}
+}
+
+// logFault keeps the last injected faults to display them.
+func (m *MultiNode) logFault(line string) {
+	if m.height > 0 {
+		line = fmt.Sprintf("height %d: %s", m.height, line)
+	}
+	m.faultLogs = append(m.faultLogs, line)
+	if len(m.faultLogs) > 5 {
+		m.faultLogs = m.faultLogs[len(m.faultLogs)-5:]
+	}
+}
+
 // StopAllNodes stops all nodes.
 func (m *MultiNode) StopAllNodes() {
 	for i := 0; i < m.numNodes; i++ {
@@ -202,12 +415,34 @@ func (m MultiNode) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "q":
 			m.StopAllNodes() // Stop all nodes before quitting
 			return m, tea.Quit
+		case keyPartition, keyLatency, keyDrop, keyPause:
+			if m.network != nil {
+				m.faultKey = msg.String()
+			}
+		case keyHeal:
+			if m.network != nil {
+				m.faultKey = ""
+				_ = m.network.Apply(chain.FaultStep{Action: chain.FaultHeal})
+				m.logFault("heal all nodes")
+			}
+		case "esc":
+			m.faultKey = ""
 		default:
 			// Check for numbers from 1 to numNodes
 			for i := 0; i < m.numNodes; i++ {
-				if msg.String() == fmt.Sprintf("%d", i+1) {
+				if msg.String() != fmt.Sprintf("%d", i+1) {
+					continue
+				}
+				if m.faultKey == "" {
 					return m, ToggleNode(i)
 				}
+
+				key := m.faultKey
+				m.faultKey = ""
+				if err := m.ToggleFault(key, i); err != nil {
+					m.logFault(err.Error())
+				}
+				return m, nil
 			}
 		}
 
@@ -222,6 +457,22 @@ func (m MultiNode) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, UpdateDeemon()
 	case UpdateLogsMsg:
 		return m, UpdateDeemon()
+
+	case UpdateHeightMsg:
+		if msg.height > m.height {
+			m.height = msg.height
+		}
+		// Inject the faults of the scenario reached by the testnet
+		for len(m.scenario) > 0 && m.scenario[0].Height <= m.height {
+			step := m.scenario[0]
+			m.scenario = m.scenario[1:]
+			if err := m.ApplyFault(step); err != nil {
+				m.logFault(fmt.Sprintf("%s: %s", step, err))
+				continue
+			}
+			m.logFault(step.String())
+		}
+		return m, PollHeight(m)
 	}
 
 	return m, nil
@@ -238,8 +489,16 @@ func (m MultiNode) View() string {
 	statusBarStyle := lipgloss.NewStyle().Background(lipgloss.Color("0"))                             // Status bar style
 	blueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("45")).Background(lipgloss.Color("0")) // blue
 
-	statusBar := blueStyle.Render("Press q to quit | Press 1-4 to ") + statusBarStyle.Render(runningStyle.Render("start")) + blueStyle.Render("/") + statusBarStyle.Render(stoppedStyle.Render("stop")) + blueStyle.Render(" corresponding node")
-	output := statusBar + "\n\n"
+	nodeKeys := fmt.Sprintf("1-%d", len(m.args.ListPorts))
+	statusBar := blueStyle.Render(fmt.Sprintf("Press q to quit | Press %s to ", nodeKeys)) + statusBarStyle.Render(runningStyle.Render("start")) + blueStyle.Render("/") + statusBarStyle.Render(stoppedStyle.Render("stop")) + blueStyle.Render(" corresponding node")
+	output := statusBar + "\n"
+	if m.network != nil {
+		output += blueStyle.Render(fmt.Sprintf("Press x/l/d/p then %s to partition/delay/drop/pause the node | Press h to heal all nodes", nodeKeys)) + "\n"
+		if m.faultKey != "" {
+			output += tcpStyle.Render(fmt.Sprintf("Select the node to %s (esc to cancel)", faultName(m.faultKey))) + "\n"
+		}
+	}
+	output += "\n"
 
 	// Add node control section
 	output += purpleStyle.Render("Node Control:")
@@ -253,6 +512,10 @@ func (m MultiNode) View() string {
 		nodeGray := grayStyle.Render("--node")
 		nodeNumber := purpleStyle.Render(fmt.Sprintf("%d.", i+1))
 
+		if faults := m.nodeFaults(i); faults != "" {
+			status += " " + tcpStyle.Render("["+faults+"]")
+		}
+
 		output += fmt.Sprintf("\n%s Node %d %s %s %s:\n", nodeNumber, i+1, status, nodeGray, tcpAddress)
 		output += " [\n"
 		if m.logs != nil {
@@ -264,6 +527,42 @@ func (m MultiNode) View() string {
 		output += " ]\n\n"
 	}
 
+	if m.network != nil {
+		output += purpleStyle.Render(fmt.Sprintf("Faults (height %d):", m.height)) + "\n"
+		for _, line := range m.faultLogs {
+			output += "  " + line + "\n"
+		}
+		if len(m.scenario) > 0 {
+			output += grayStyle.Render(fmt.Sprintf("  next at height %d: %s", m.scenario[0].Height, m.scenario[0])) + "\n"
+		}
+	}
+
 	output += grayStyle.Render("\nPress q to quit.\n")
 	return output
 }
+
+// nodeFaults returns a description of the faults injected in the node.
+func (m MultiNode) nodeFaults(nodeIdx int) string {
+	var faults []string
+	if m.network != nil {
+		faults = describeFaults(m.network.Faults(nodeIdx))
+	}
+	if m.paused[nodeIdx] {
+		faults = append(faults, "paused")
+	}
+	return strings.Join(faults, ", ")
+}
+
+func describeFaults(f faultproxy.Faults) []string {
+	var faults []string
+	if f.Partitioned {
+		faults = append(faults, "partitioned")
+	}
+	if f.Latency > 0 {
+		faults = append(faults, fmt.Sprintf("latency %s ± %s", f.Latency, f.Jitter))
+	}
+	if f.DropRate > 0 {
+		faults = append(faults, fmt.Sprintf("drop %.0f%%", f.DropRate*100))
+	}
+	return faults
+}
@@ -17,10 +17,12 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosgen"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/goanalysis"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/v29/ignite/pkg/xast"
 	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
 	"github.com/ignite/cli/v29/ignite/services/chain"
 	"github.com/ignite/cli/v29/ignite/services/doctor"
+	"github.com/ignite/cli/v29/ignite/services/workspace"
 )
 
 const (
@@ -89,6 +91,12 @@ to send token from any other account that exists on chain.
 
 The "simulate" command helps you start a simulation testing process for your
 chain.
+
+The "test" command runs the unit, integration and simulation tests of your
+chain and reports the coverage of its modules.
+
+The "bench" command tracks the gas used by the messages of your chain against
+a baseline recorded in the app.
 `,
 		Aliases:           []string{"c"},
 		Args:              cobra.ExactArgs(1),
@@ -107,6 +115,8 @@ chain.
 		NewChainSimulate(),
 		NewChainDebug(),
 		NewChainLint(),
+		NewChainTest(),
+		NewChainBench(),
 	)
 
 	return c
@@ -116,6 +126,11 @@ func preRunHandler(cmd *cobra.Command, _ []string) error {
 	session := cliui.New()
 	defer session.End()
 
+	// The chains of a workspace are migrated instead of the chain of the flags
+	if workspaceFile, _ := cmd.Flags().GetString(flagWorkspace); workspaceFile != "" {
+		return workspaceMigrationPreRunHandler(cmd, session, workspaceFile)
+	}
+
 	appPath, err := goModulePath(cmd)
 	if err != nil {
 		return err
@@ -126,6 +141,15 @@ func preRunHandler(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	return migrationPreRunHandlers(cmd, session, appPath, cfgPath, cfg)
+}
+
+func migrationPreRunHandlers(
+	cmd *cobra.Command,
+	session *cliui.Session,
+	appPath, cfgPath string,
+	cfg *chainconfig.Config,
+) error {
 	if err := configMigrationPreRunHandler(cmd, session, appPath, cfgPath); err != nil {
 		return err
 	}
@@ -137,6 +161,37 @@ func preRunHandler(cmd *cobra.Command, _ []string) error {
 	return bufMigrationPreRunHandler(cmd, session, appPath, cfg.Build.Proto.Path)
 }
 
+func workspaceMigrationPreRunHandler(cmd *cobra.Command, session *cliui.Session, workspaceFile string) error {
+	cfg, err := workspace.ParseConfig(workspaceFile)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range cfg.Chains {
+		_, appPath, err := gomodulepath.Find(c.Path)
+		if err != nil {
+			return errors.Errorf("chain %s: %w", c.Name, err)
+		}
+
+		cfgPath := c.Config
+		if cfgPath == "" {
+			if cfgPath, err = chainconfig.LocateDefault(appPath); err != nil {
+				return errors.Errorf("chain %s: %w", c.Name, err)
+			}
+		}
+
+		chainCfg, err := chainconfig.ParseFile(cfgPath)
+		if err != nil {
+			return errors.Errorf("chain %s: %w", c.Name, err)
+		}
+
+		if err := migrationPreRunHandlers(cmd, session, appPath, cfgPath, chainCfg); err != nil {
+			return errors.Errorf("chain %s: %w", c.Name, err)
+		}
+	}
+	return nil
+}
+
 func toolsMigrationPreRunHandler(cmd *cobra.Command, session *cliui.Session, appPath string) error {
 	session.StartSpinner("Checking missing tools...")
 
@@ -0,0 +1,172 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+const (
+	flagBenchBaseline  = "baseline"
+	flagBenchThreshold = "threshold"
+	flagBenchUpdate    = "update"
+	flagBenchtime      = "benchtime"
+)
+
+// NewChainBench returns a command to benchmark the gas used by the messages of the blockchain.
+func NewChainBench() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "bench",
+		Short: "Track the gas used by the messages of the blockchain against a baseline",
+		Long: `Run the message benchmarks of the modules and compare the gas used by each
+message type with the baseline recorded in the app.
+
+The benchmarks deliver the messages in transactions of an in-process app. A
+benchmark is scaffolded in "x/{module}/integration" with each message created
+with "ignite scaffold message" in apps with the integration test harness, created
+by "ignite scaffold module --with-integration-tests". Set the values of the
+message fields of the benchmark to a representative message.
+
+The gas used and the time per message are recorded in a JSON baseline, which
+is meant to be committed in the repository of the app. The baseline is created
+on the first run, and updated with the "--update" flag:
+
+	ignite chain bench --update
+
+The command fails when the gas used by a message type increases by more than
+the threshold, in percent, compared to the baseline:
+
+	ignite chain bench --threshold 2
+`,
+		Args: cobra.NoArgs,
+		RunE: chainBenchHandler,
+	}
+
+	c.Flags().StringSlice(flagModule, nil, "modules to benchmark, all the modules are benchmarked by default")
+	c.Flags().String(flagBenchBaseline, chain.DefaultBenchBaseline, "path of the gas baseline, relative to the app")
+	c.Flags().Float64(flagBenchThreshold, 5, "maximum increase of the gas used by a message, in percent")
+	c.Flags().Bool(flagBenchUpdate, false, "update the baseline with the results of the benchmarks")
+	c.Flags().String(flagBenchtime, "", `run time of each benchmark, like "2s" or "100x"`)
+
+	return c
+}
+
+func chainBenchHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		modules, _      = cmd.Flags().GetStringSlice(flagModule)
+		baselinePath, _ = cmd.Flags().GetString(flagBenchBaseline)
+		threshold, _    = cmd.Flags().GetFloat64(flagBenchThreshold)
+		update, _       = cmd.Flags().GetBool(flagBenchUpdate)
+		benchtime, _    = cmd.Flags().GetString(flagBenchtime)
+	)
+
+	if threshold < 0 {
+		return errors.Errorf("invalid threshold %v, it must be positive", threshold)
+	}
+
+	session := cliui.New(cliui.StartSpinnerWithText("Benchmarking..."))
+	defer session.End()
+
+	c, err := chain.NewWithHomeFlags(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !filepath.IsAbs(baselinePath) {
+		baselinePath = filepath.Join(c.AppPath(), baselinePath)
+	}
+
+	baseline, err := chain.LoadBenchBaseline(baselinePath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		// The baseline is created from the results of the first run
+		update = true
+	case err != nil:
+		return err
+	}
+
+	results, err := c.Bench(
+		cmd.Context(),
+		chain.BenchWithModules(modules...),
+		chain.BenchWithBenchtime(benchtime),
+	)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if len(results) == 0 {
+		return session.Printf(
+			"%s No message benchmark found, messages scaffolded with %s have one\n",
+			icons.Info,
+			colors.Faint("ignite scaffold message"),
+		)
+	}
+
+	comparisons := baseline.Compare(results, threshold)
+	if err := printBenchComparisons(session, comparisons); err != nil {
+		return err
+	}
+
+	if update {
+		if err := baseline.Update(results).Save(baselinePath); err != nil {
+			return err
+		}
+		return session.Printf("\n%s Gas baseline updated: %s\n", icons.OK, colors.Faint(baselinePath))
+	}
+
+	var regressions int
+	for _, comparison := range comparisons {
+		if comparison.Regressed {
+			regressions++
+		}
+	}
+	if regressions > 0 {
+		return errors.Errorf("the gas used by %d message types increased by more than %v%%", regressions, threshold)
+	}
+	return session.Printf("\n%s No gas regression beyond %v%%\n", icons.OK, threshold)
+}
+
+// printBenchComparisons prints the gas used and the time per message type with their baseline.
+func printBenchComparisons(session *cliui.Session, comparisons []chain.BenchComparison) error {
+	rows := make([][]string, 0, len(comparisons))
+	for _, c := range comparisons {
+		var (
+			baseline = colors.Faint("-")
+			change   = colors.Faint("new")
+		)
+		if c.Baseline != nil {
+			baseline = fmt.Sprint(c.Baseline.GasUsed)
+			change = fmt.Sprintf("%+.2f%%", c.GasChange)
+			switch {
+			case c.Regressed:
+				change = colors.Error(change)
+			case c.GasChange < 0:
+				change = colors.Success(change)
+			}
+		}
+		rows = append(rows, []string{
+			c.Module,
+			c.Message,
+			fmt.Sprint(c.GasUsed),
+			baseline,
+			change,
+			time.Duration(c.NsPerOp).Round(time.Microsecond).String(),
+		})
+	}
+	return session.PrintTable([]string{"Module", "Message", "Gas", "Baseline", "Change", "Time"}, rows...)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/events"
 	"github.com/ignite/cli/v29/ignite/services/chain"
+	"github.com/ignite/cli/v29/ignite/services/workspace"
 )
 
 const (
@@ -22,6 +23,8 @@ const (
 	flagGenerateClients = "generate-clients"
 	flagQuitOnFail      = "quit-on-fail"
 	flagResetOnce       = "reset-once"
+	flagValidators      = "validators"
+	flagWorkspace       = "workspace"
 )
 
 // NewChainServe creates a new serve command to serve a blockchain.
@@ -58,6 +61,54 @@ from one blockchain to another. To start a node using a specific config file:
 
 	ignite chain serve --config mars.yml
 
+To run a local network with a node for each validator defined in the config
+file, for example to exercise slashing, jailing or consensus edge cases, use
+the following flag:
+
+	ignite chain serve --validators
+
+The first validator runs in the chain's home and the other validators run in
+the home defined in their config or, by default, in a directory next to the
+chain's home suffixed with the validator name. The ports of the validators
+are incremented by 10 for each validator unless they are defined in the config.
+The validator accounts must be defined in the accounts of the config file.
+
+While the node is running, press the "i" key to display the state of the chain:
+the latest height and block time, the size of the mempool, the recent
+transactions with their decoded messages and events, and the size of the
+module stores. Use the up and down keys to select a transaction.
+
+To develop inter-blockchain functionality, for example packets scaffolded with
+"ignite scaffold packet", serve several blockchains and a relayer with a
+workspace file:
+
+	ignite chain serve --workspace ignite-workspace.yml
+
+The workspace file lists the blockchains, with the path of their source code and
+optionally their config file and home, and the channels opened between their
+modules:
+
+	chains:
+	  - name: mars
+	    path: ./mars
+	  - name: venus
+	    path: ./venus
+	relayer:
+	  channels:
+	    - a: { chain: mars, port: blog }
+	      b: { chain: venus, port: blog }
+	      version: blog-1
+	      order: unordered
+
+The paths are relative to the workspace file. The default ports of each
+blockchain are incremented by 100 for each blockchain of the workspace to avoid
+port clashing. When channels are defined, a funded "relayer" account is added to
+the blockchains and, once they are started, the channels that are not already
+opened are created and their packets are relayed with Hermes
+(https://hermes.informal.systems), which must be installed. The binary of the
+relayer and the name of its account can be set with the "binary" and "account"
+keys of the relayer.
+
 The serve command is meant to be used ONLY FOR DEVELOPMENT PURPOSES. Under the
 hood, it runs "appd start", where "appd" is the name of your chain's binary. For
 production, you may want to run "appd start" manually.
@@ -77,12 +128,18 @@ production, you may want to run "appd start" manually.
 	c.Flags().BoolP(flagResetOnce, "r", false, "reset the app state once on init")
 	c.Flags().Bool(flagGenerateClients, false, "generate code for the configured clients on reset or source code change")
 	c.Flags().Bool(flagQuitOnFail, false, "quit program if the app fails to start")
+	c.Flags().Bool(flagValidators, false, "run a node for each validator defined in the config")
+	c.Flags().String(flagWorkspace, "", "path to a workspace file to serve several blockchains and a relayer")
 	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binary")
 
 	return c
 }
 
 func chainServeHandler(cmd *cobra.Command, _ []string) error {
+	if workspaceFile, _ := cmd.Flags().GetString(flagWorkspace); workspaceFile != "" {
+		return chainServeWorkspace(cmd, workspaceFile)
+	}
+
 	var options []cliui.Option
 
 	// Session must not handle events when the verbosity is the default
@@ -105,8 +162,16 @@ func chainServeHandler(cmd *cobra.Command, _ []string) error {
 		bus := session.EventBus()
 		bus.Send("Initializing...", events.ProgressStart())
 
+		// The UI displays the state of the chain collected by the inspector
+		inspector := chain.NewStateInspector()
+
 		// Render UI
-		m := cmdmodel.NewChainServe(cmd, bus, chainServeCmd(cmd, session))
+		m := cmdmodel.NewChainServe(
+			cmd,
+			bus,
+			chainServeCmd(cmd, session, chain.ServeStateInspector(inspector)),
+			cmdmodel.WithStateInspector(inspector),
+		)
 		_, err := tea.NewProgram(m, tea.WithInput(cmd.InOrStdin())).Run()
 		return err
 	}
@@ -115,16 +180,16 @@ func chainServeHandler(cmd *cobra.Command, _ []string) error {
 	return chainServe(cmd, session)
 }
 
-func chainServeCmd(cmd *cobra.Command, session *cliui.Session) tea.Cmd {
+func chainServeCmd(cmd *cobra.Command, session *cliui.Session, options ...chain.ServeOption) tea.Cmd {
 	return func() tea.Msg {
-		if err := chainServe(cmd, session); err != nil && !errors.Is(err, context.Canceled) {
+		if err := chainServe(cmd, session, options...); err != nil && !errors.Is(err, context.Canceled) {
 			return cliuimodel.ErrorMsg{Error: err}
 		}
 		return cliuimodel.QuitMsg{}
 	}
 }
 
-func chainServe(cmd *cobra.Command, session *cliui.Session) error {
+func chainServe(cmd *cobra.Command, session *cliui.Session, options ...chain.ServeOption) error {
 	chainOption := []chain.Option{
 		chain.WithOutputer(session),
 		chain.CollectEvents(session.EventBus()),
@@ -153,6 +218,60 @@ func chainServe(cmd *cobra.Command, session *cliui.Session) error {
 	}
 
 	// serve the chain
+	options = append(options, chainServeOptions(cmd)...)
+	return c.Serve(cmd.Context(), cacheStorage, options...)
+}
+
+// chainServeWorkspace serves the blockchains of a workspace file with a relayer.
+func chainServeWorkspace(cmd *cobra.Command, workspaceFile string) error {
+	for _, name := range []string{flagPath, flagConfig, flagHome} {
+		if cmd.Flags().Changed(name) {
+			return errors.Errorf("--%s can't be used with --%s, define it for each blockchain of the workspace", name, flagWorkspace)
+		}
+	}
+
+	// The events of the blockchains are displayed one after
+	// the other because the custom UI displays a single chain
+	session := cliui.New(cliui.WithVerbosity(getVerbosity(cmd)))
+	defer session.End()
+
+	cfg, err := workspace.ParseConfig(workspaceFile)
+	if err != nil {
+		return err
+	}
+
+	chainOption := []chain.Option{
+		chain.CheckCosmosSDKVersion(),
+	}
+
+	if flagGetCheckDependencies(cmd) {
+		chainOption = append(chainOption, chain.CheckDependencies())
+	}
+
+	w, err := workspace.New(
+		cfg,
+		workspace.CollectEvents(session.EventBus()),
+		workspace.WithOutputer(session),
+		workspace.WithChainOptions(chainOption...),
+	)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	err = w.Serve(cmd.Context(), cacheStorage, chainServeOptions(cmd)...)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// chainServeOptions returns the serve options defined by the flags.
+func chainServeOptions(cmd *cobra.Command) []chain.ServeOption {
 	var serveOptions []chain.ServeOption
 
 	forceUpdate, _ := cmd.Flags().GetBool(flagForceReset)
@@ -175,6 +294,11 @@ func chainServe(cmd *cobra.Command, session *cliui.Session) error {
 		serveOptions = append(serveOptions, chain.GenerateClients())
 	}
 
+	validators, _ := cmd.Flags().GetBool(flagValidators)
+	if validators {
+		serveOptions = append(serveOptions, chain.ServeValidators())
+	}
+
 	buildTags, _ := cmd.Flags().GetStringSlice(flagBuildTags)
 	if len(buildTags) > 0 {
 		serveOptions = append(serveOptions, chain.BuildTags(buildTags...))
@@ -188,9 +312,5 @@ func chainServe(cmd *cobra.Command, session *cliui.Session) error {
 		serveOptions = append(serveOptions, chain.ServeSkipBuild())
 	}
 
-	if quitOnFail {
-		serveOptions = append(serveOptions, chain.QuitOnFail())
-	}
-
-	return c.Serve(cmd.Context(), cacheStorage, serveOptions...)
+	return serveOptions
 }
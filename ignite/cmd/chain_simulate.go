@@ -1,12 +1,17 @@
 package ignitecmd
 
 import (
+	"fmt"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/services/chain"
 )
 
@@ -24,6 +29,8 @@ const (
 	flagSimappLean               = "lean"
 	flagSimappGenesisTime        = "genesisTime"
 	flagSimName                  = "simName"
+	flagSimSeeds                 = "seeds"
+	flagSimParallel              = "parallel"
 )
 
 // NewChainSimulate creates a new simulation command to run the blockchain simulation.
@@ -31,9 +38,20 @@ func NewChainSimulate() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "simulate",
 		Short: "Run simulation testing for the blockchain",
-		Long:  "Run simulation testing for the blockchain. It sends many randomized-input messages of each module to a simulated node.",
-		Args:  cobra.NoArgs,
-		RunE:  chainSimulationHandler,
+		Long: `Run simulation testing for the blockchain. It sends many randomized-input messages of each module to a simulated node.
+
+Several seeds can be simulated concurrently, starting from the seed flag, for
+example to run the simulation with the seeds 1 to 100:
+
+	ignite chain simulate --seed 1 --seeds 100
+
+The summary of the results, with the operation statistics of each seed, is
+written in a new directory of "simulations" in the app directory, with a
+reproduction bundle for each failed seed containing the output of the
+simulation, the command to reproduce it and the genesis and params files.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainSimulationHandler,
 	}
 	simappFlags(c)
 	return c
@@ -61,11 +79,49 @@ func chainSimulationHandler(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	return c.Simulate(cmd.Context(),
+	options := []chain.SimappOption{
 		chain.SimappWithSimulationTestName(simName),
 		chain.SimappWithGenesisTime(genesisTime),
 		chain.SimappWithConfig(config),
-	)
+	}
+
+	seeds, _ := cmd.Flags().GetInt(flagSimSeeds)
+	if seeds <= 1 {
+		return c.Simulate(cmd.Context(), options...)
+	}
+
+	parallel, _ := cmd.Flags().GetInt(flagSimParallel)
+	options = append(options, chain.SimappWithParallel(parallel))
+
+	return chainSimulateSeeds(cmd, absPath, config.Seed, seeds, options)
+}
+
+// chainSimulateSeeds simulates several seeds starting from the first one and prints the summary.
+func chainSimulateSeeds(cmd *cobra.Command, appPath string, firstSeed int64, count int, options []chain.SimappOption) error {
+	session := cliui.New(cliui.StartSpinnerWithText(fmt.Sprintf("Simulating %d seeds...", count)))
+	defer session.End()
+
+	c, err := chain.New(appPath, chain.WithOutputer(session), chain.CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	seeds := make([]int64, count)
+	for i := range seeds {
+		seeds[i] = firstSeed + int64(i)
+	}
+
+	summary, err := c.SimulateSeeds(cmd.Context(), seeds, options...)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+	_ = session.Printf("%s Simulation summary: %s\n", icons.Bullet, colors.Faint(summary.Dir))
+	if summary.Failed > 0 {
+		return errors.Errorf("%d of %d seeds failed", summary.Failed, len(seeds))
+	}
+	return session.Printf("%s All the %d seeds passed\n", icons.OK, len(seeds))
 }
 
 // newConfigFromFlags creates a simulation from the retrieved values of the flags.
@@ -116,4 +172,6 @@ func simappFlags(c *cobra.Command) {
 	// simulation flags
 	c.Flags().String(flagSimName, "TestFullAppSimulation", "name of the simulation to run")
 	c.Flags().Int64(flagSimappGenesisTime, 0, "override genesis UNIX time instead of using a random UNIX time")
+	c.Flags().Int(flagSimSeeds, 1, "number of seeds to simulate, starting from the seed")
+	c.Flags().Int(flagSimParallel, 0, "maximum number of seeds simulated concurrently (default: the number of CPUs)")
 }
@@ -0,0 +1,241 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+	"github.com/ignite/cli/v29/ignite/pkg/gotest"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+const (
+	flagTestSuite        = "suite"
+	flagTestJUnit        = "junit"
+	flagTestCoverageHTML = "coverage-html"
+	flagTestCoverProfile = "coverprofile"
+)
+
+// NewChainTest returns a command to run the tests of the blockchain.
+func NewChainTest() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "test",
+		Short: "Run the unit, integration and simulation tests of the blockchain",
+		Long: `Run the test suites of the blockchain and report their results with the
+coverage of each module.
+
+The suites are:
+
+* unit: the tests of the packages of the app
+* integration: the tests of the integration test packages, like the ones
+  scaffolded with "ignite scaffold module --with-integration-tests"
+* simulation: the simulation tests of the app, run with the simulation flags
+
+The coverage of the modules in "x/" is collected from all the suites. The unit
+and integration tests and the coverage can be restricted to modules:
+
+	ignite chain test --module blog --suite unit,integration
+
+For continuous integration, the results can be written in the JUnit XML
+format and the coverage in an HTML report:
+
+	ignite chain test --junit report.xml --coverage-html coverage.html
+`,
+		Args: cobra.NoArgs,
+		RunE: chainTestHandler,
+	}
+
+	suites := make([]string, len(chain.TestSuites))
+	for i, s := range chain.TestSuites {
+		suites[i] = string(s)
+	}
+
+	c.Flags().StringSlice(flagTestSuite, suites, fmt.Sprintf("test suites to run [%s]", strings.Join(suites, "|")))
+	c.Flags().StringSlice(flagModule, nil, "modules to test, all the modules are tested by default")
+	c.Flags().String(flagTestJUnit, "", "write the test results in the JUnit XML format to the file")
+	c.Flags().String(flagTestCoverageHTML, "", "write the HTML coverage report to the file")
+	c.Flags().String(flagTestCoverProfile, "", "write the coverage profile to the file")
+	c.Flags().Int64(flagSimappSeed, 42, "simulation random seed")
+	c.Flags().Int(flagSimappNumBlocks, 50, "number of blocks of the simulation tests")
+	c.Flags().Int(flagSimappBlockSize, 20, "operations per block of the simulation tests")
+
+	return c
+}
+
+func chainTestHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		suiteNames, _   = cmd.Flags().GetStringSlice(flagTestSuite)
+		modules, _      = cmd.Flags().GetStringSlice(flagModule)
+		junitPath, _    = cmd.Flags().GetString(flagTestJUnit)
+		htmlPath, _     = cmd.Flags().GetString(flagTestCoverageHTML)
+		coverProfile, _ = cmd.Flags().GetString(flagTestCoverProfile)
+		seed, _         = cmd.Flags().GetInt64(flagSimappSeed)
+		numBlocks, _    = cmd.Flags().GetInt(flagSimappNumBlocks)
+		blockSize, _    = cmd.Flags().GetInt(flagSimappBlockSize)
+	)
+
+	var suites []chain.TestSuite
+	for _, name := range suiteNames {
+		suite := chain.TestSuite(name)
+		if !slices.Contains(chain.TestSuites, suite) {
+			return errors.Errorf("unknown test suite %q", name)
+		}
+		suites = append(suites, suite)
+	}
+
+	session := cliui.New(cliui.StartSpinnerWithText("Testing..."))
+	defer session.End()
+
+	c, err := chain.NewWithHomeFlags(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	)
+	if err != nil {
+		return err
+	}
+
+	// The coverage profile is required to render the HTML report
+	if htmlPath != "" && coverProfile == "" {
+		tmpDir, err := os.MkdirTemp("", "ignite-chain-test-coverage")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+		coverProfile = filepath.Join(tmpDir, "coverage.out")
+	}
+
+	options := []chain.TestOption{
+		chain.TestWithSuites(suites...),
+		chain.TestWithModules(modules...),
+		chain.TestWithSimulationConfig(simulation.Config{
+			Seed:      seed,
+			NumBlocks: numBlocks,
+			BlockSize: blockSize,
+		}),
+	}
+	if coverProfile != "" {
+		if coverProfile, err = filepath.Abs(coverProfile); err != nil {
+			return err
+		}
+		options = append(options, chain.TestWithCoverProfile(coverProfile))
+	}
+
+	report, err := c.Test(cmd.Context(), options...)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if err := printTestReport(session, report); err != nil {
+		return err
+	}
+
+	if junitPath != "" {
+		if err := writeJUnitReport(junitPath, report.Report()); err != nil {
+			return err
+		}
+		_ = session.Printf("%s JUnit report: %s\n", icons.Bullet, colors.Faint(junitPath))
+	}
+
+	if htmlPath != "" {
+		if htmlPath, err = filepath.Abs(htmlPath); err != nil {
+			return err
+		}
+		if err := gocmd.CoverHTML(cmd.Context(), c.AppPath(), coverProfile, htmlPath); err != nil {
+			return err
+		}
+		_ = session.Printf("%s Coverage report: %s\n", icons.Bullet, colors.Faint(htmlPath))
+	}
+
+	if report.Failed() {
+		return errors.New("tests failed")
+	}
+	return session.Printf("%s All the tests passed\n", icons.OK)
+}
+
+// printTestReport prints the failed tests, the results of the suites and the coverage of the modules.
+func printTestReport(session *cliui.Session, report chain.TestReport) error {
+	for _, s := range report.Suites {
+		for _, p := range s.Packages {
+			for _, t := range p.Tests {
+				if t.Status != gotest.ActionFail {
+					continue
+				}
+				_ = session.Printf("%s %s %s\n%s\n", icons.NotOK, p.Name, colors.Error(t.Name), t.Output)
+			}
+			if p.Status == gotest.ActionFail && p.Count(gotest.ActionFail) == 0 {
+				_ = session.Printf("%s %s\n%s\n", icons.NotOK, colors.Error(p.Name), p.Output)
+			}
+		}
+	}
+
+	rows := make([][]string, 0, len(report.Suites))
+	for _, s := range report.Suites {
+		status := colors.Success("ok")
+		switch {
+		case len(s.Packages) == 0:
+			status = colors.Faint("no tests")
+		case s.Failed():
+			status = colors.Error("failed")
+		}
+		rows = append(rows, []string{
+			string(s.Suite),
+			status,
+			fmt.Sprint(len(s.Packages)),
+			fmt.Sprint(s.Count(gotest.ActionPass)),
+			fmt.Sprint(s.Count(gotest.ActionFail)),
+			fmt.Sprint(s.Count(gotest.ActionSkip)),
+			s.Elapsed().Round(10 * time.Millisecond).String(),
+		})
+	}
+	if err := session.PrintTable([]string{"Suite", "Status", "Packages", "Passed", "Failed", "Skipped", "Time"}, rows...); err != nil {
+		return err
+	}
+
+	if len(report.Coverage) == 0 {
+		return nil
+	}
+
+	modules := make([]string, 0, len(report.Coverage))
+	for m := range report.Coverage {
+		modules = append(modules, m)
+	}
+	sort.Strings(modules)
+
+	rows = make([][]string, 0, len(modules))
+	for _, m := range modules {
+		c := report.Coverage[m]
+		rows = append(rows, []string{
+			m,
+			fmt.Sprintf("%d/%d", c.Covered, c.Statements),
+			fmt.Sprintf("%.1f%%", c.Percent()),
+		})
+	}
+	_ = session.Println()
+	return session.PrintTable([]string{"Module", "Statements", "Coverage"}, rows...)
+}
+
+func writeJUnitReport(path string, report gotest.Report) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return report.WriteJUnit(f)
+}
@@ -37,6 +37,7 @@ meant to be edited by hand.
 	c.AddCommand(NewGenerateComposables())
 	c.AddCommand(NewGenerateHooks())
 	c.AddCommand(NewGenerateOpenAPI())
+	c.AddCommand(NewGenerateMockServer())
 
 	return c
 }
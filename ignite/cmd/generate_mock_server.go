@@ -0,0 +1,132 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	uilog "github.com/ignite/cli/v29/ignite/pkg/cliui/log"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosmock"
+	"github.com/ignite/cli/v29/ignite/pkg/xhttp"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+const (
+	flagFixtures = "fixtures"
+	flagListen   = "listen"
+)
+
+// NewGenerateMockServer returns a command to serve mocked responses for the HTTP queries of the chain.
+func NewGenerateMockServer() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "mock-server",
+		Short: "Serve mocked API responses for frontend development",
+		Long: `Serve mocked responses for the HTTP queries of the modules of your chain, to
+develop a frontend, like the Vue or React apps scaffolded by Ignite, without
+building or starting the chain.
+
+The modules registered in the app are discovered from the proto files of the
+app and of its dependencies, and their HTTP queries are served on the API
+address of the first validator of the config. A query responds with an example
+of its response message, created from the proto files.
+
+Realistic data can be served with fixture files: the fixture of a query is the
+JSON file named after the query in the directory of its module, for example:
+
+	ignite generate mock-server --fixtures mocks
+
+serves the content of "mocks/bank/AllBalances.json" for the bank balances
+query. Fixtures are read on each request, so they can be edited while the
+server is running. Use the verbose flag to list the routes with their fixture.
+`,
+		Args: cobra.NoArgs,
+		RunE: generateMockServerHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetConfig())
+	c.Flags().AddFlagSet(flagSetVerbose())
+	c.Flags().String(flagFixtures, "", "directory of the fixture files of the queries")
+	c.Flags().String(flagListen, "", "address to serve the mocked API on (default: the configured API address)")
+
+	return c
+}
+
+func generateMockServerHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New(
+		cliui.StartSpinner(),
+		cliui.WithVerbosity(getVerbosity(cmd)),
+	)
+	defer session.End()
+
+	chainOption := []chain.Option{
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+
+	if config, _ := cmd.Flags().GetString(flagConfig); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	var options []cosmosmock.Option
+	fixtures, _ := cmd.Flags().GetString(flagFixtures)
+	if fixtures != "" {
+		if fixtures, err = filepath.Abs(fixtures); err != nil {
+			return err
+		}
+		options = append(options, cosmosmock.WithFixtures(fixtures))
+	}
+
+	server, err := c.MockServer(cmd.Context(), cacheStorage, options...)
+	if err != nil {
+		return err
+	}
+
+	address, _ := cmd.Flags().GetString(flagListen)
+	if address == "" {
+		if address, err = c.APIAddress(); err != nil {
+			return err
+		}
+	}
+
+	session.StopSpinner()
+
+	routes := server.Routes()
+	if getVerbosity(cmd) == uilog.VerbosityVerbose {
+		for _, r := range routes {
+			route := fmt.Sprintf("%s %s", r.Method, r.Endpoint)
+			if fixtures != "" {
+				route = fmt.Sprintf("%s %s", route, colors.Faint(cosmosmock.FixturePath(fixtures, r)))
+			}
+			_ = session.Println(icons.Bullet, route)
+		}
+	}
+
+	apiAddr, err := xurl.HTTP(address)
+	if err != nil {
+		return err
+	}
+	_ = session.Printf("%s Mocking %d queries on %s\n", icons.Earth, len(routes), apiAddr)
+
+	return xhttp.Serve(cmd.Context(), &http.Server{
+		Addr:              address,
+		Handler:           server,
+		ReadHeaderTimeout: 5 * time.Second, // Set a reasonable timeout
+	})
+}
@@ -1,6 +1,12 @@
 package ignitecmd
 
 import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -8,6 +14,8 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosver"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/placeholder"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
 	"github.com/ignite/cli/v29/ignite/pkg/xgit"
 	"github.com/ignite/cli/v29/ignite/services/scaffolder"
 	"github.com/ignite/cli/v29/ignite/version"
@@ -21,6 +29,7 @@ const (
 	flagResponse     = "response"
 	flagDescription  = "desc"
 	flagProtoDir     = "proto-dir"
+	flagDryRun       = "dry-run"
 
 	msgCommitPrefix = "Your saved project changes have not been committed.\nTo enable reverting to your current state, commit your saved changes."
 	msgCommitPrompt = "Do you want to proceed without committing your saved changes"
@@ -67,6 +76,10 @@ system before running scaffolding commands. This will make it easier to see the
 changes to the source code as well as undo the command if you've decided to roll
 back the changes.
 
+To preview the changes of a scaffolding command without applying them, use the
+"--dry-run" flag. The created and modified files are printed as a unified diff
+along with the placeholders found and missing in the source code.
+
 This blockchain you create with the chain scaffolding command uses the modular
 Cosmos SDK framework and imports many standard modules for functionality like
 proof of stake, token transfer, inter-blockchain connectivity, governance, and
@@ -125,9 +138,14 @@ with an "--ibc" flag. Note that the default module is not IBC-enabled.
 		NewScaffoldMessage(),
 		NewScaffoldQuery(),
 		NewScaffoldPacket(),
+		NewScaffoldIBCMiddleware(),
 		NewScaffoldVue(),
 		NewScaffoldReact(),
 		NewScaffoldChainRegistry(),
+		NewScaffoldWasm(),
+		NewScaffoldApply(),
+		NewScaffoldRemove(),
+		NewScaffoldField(),
 	)
 
 	return c
@@ -160,6 +178,11 @@ func migrationPreRunHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// A dry run must not modify the app, so the migrations are skipped
+	if flagGetDryRun(cmd) {
+		return nil
+	}
+
 	if err := toolsMigrationPreRunHandler(cmd, session, appPath); err != nil {
 		return err
 	}
@@ -220,6 +243,9 @@ func scaffoldType(
 	}
 
 	err = sc.AddType(cmd.Context(), typeName, kind, options...)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -245,8 +271,8 @@ func scaffoldType(
 }
 
 func gitChangesConfirmPreRunHandler(cmd *cobra.Command, _ []string) error {
-	// Don't confirm when the "--yes" flag is present
-	if getYes(cmd) {
+	// Don't confirm when the "--yes" or "--dry-run" flag is present
+	if getYes(cmd) || flagGetDryRun(cmd) {
 		return nil
 	}
 
@@ -278,6 +304,126 @@ func confirmWhenUncommittedChanges(session *cliui.Session, appPath string) error
 	return nil
 }
 
+// scaffoldDryRun prints the modifications staged by the scaffolder, as a unified diff,
+// with a summary of the placeholders found and missing, and discards them.
+func scaffoldDryRun(session *cliui.Session, sc scaffolder.Scaffolder, scaffoldErr error) error {
+	if scaffoldErr != nil {
+		return scaffoldErr
+	}
+
+	sm, diff, err := sc.PreviewModifications()
+	if err != nil {
+		return err
+	}
+
+	return printDryRun(session, sm, diff, sc.Tracer())
+}
+
+// printDryRun prints the result of a scaffolding dry run.
+func printDryRun(
+	session *cliui.Session,
+	sm xgenny.SourceModification,
+	diff string,
+	tracer *placeholder.Tracer,
+) error {
+	session.StopSpinner()
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Println()
+	session.Print(diff)
+
+	if tracer != nil {
+		if found := tracer.Found(); len(found) > 0 {
+			session.Printf("\nPlaceholders found:\n\n- %s\n", strings.Join(found, "\n- "))
+		}
+		if missing := tracer.Missing(); len(missing) > 0 {
+			session.Printf("\nPlaceholders missing:\n\n- %s\n", strings.Join(missing, "\n- "))
+		}
+	}
+
+	return session.Printf("\n🔍 Dry run, no changes have been applied.\n\n")
+}
+
+// scaffoldDryRunDir prints the files written by the scaffolding function in a temporary directory
+// as the modifications of the target directory, as a unified diff, and discards them.
+// It is used by the commands that write their files directly instead of staging them in a runner.
+func scaffoldDryRunDir(
+	session *cliui.Session,
+	target string,
+	tracer *placeholder.Tracer,
+	scaffold func(dir string) error,
+) error {
+	target, err := filepath.Abs(target)
+	if err != nil {
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "ignite-dry-run")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := scaffold(tmpDir); err != nil {
+		return err
+	}
+
+	sm := xgenny.NewSourceModification()
+	err = filepath.WalkDir(tmpDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(tmpDir, path)
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Join(target, relPath)
+		origin, err := os.ReadFile(name)
+		switch {
+		case os.IsNotExist(err):
+			sm.AppendCreatedFiles(name)
+		case err != nil:
+			return err
+		default:
+			modified, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(origin, modified) {
+				sm.AppendModifiedFiles(name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	diff, err := xgenny.DiffFiles(target, tmpDir, sm)
+	if err != nil {
+		return err
+	}
+
+	return printDryRun(session, sm, diff, tracer)
+}
+
+func flagSetDryRun() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.Bool(flagDryRun, false, "print the changes as a unified diff without applying them")
+	return f
+}
+
+func flagGetDryRun(cmd *cobra.Command) bool {
+	dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+	return dryRun
+}
+
 func flagSetScaffoldType() *flag.FlagSet {
 	f := flag.NewFlagSet("", flag.ContinueOnError)
 	f.String(flagModule, "", "specify which module to generate code in")
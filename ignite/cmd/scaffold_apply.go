@@ -0,0 +1,130 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+// NewScaffoldApply returns the command to scaffold components from a blueprint file.
+func NewScaffoldApply() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "apply [blueprint.yml]",
+		Short: "Scaffold modules, types, messages, queries and packets from a blueprint file",
+		Long: `Scaffold several components in a single run from a YAML blueprint file.
+
+A blueprint describes the modules to create with their dependencies, params and
+IBC settings, followed by the types, messages, queries and packets to add to
+them. All the components are validated before anything is scaffolded, then they
+are scaffolded in order: modules, types, messages, queries and packets. The
+components are scaffolded in a copy of the app, which is only modified once all
+of them succeeded. Proto files are generated once, after the last component has
+been scaffolded.
+
+	modules:
+	  - name: blog
+	    deps: [bank]
+	    params: [max_title_length:uint]
+	  - name: bridge
+	    ibc: true
+	    ordering: unordered
+	types:
+	  - name: post
+	    kind: list
+	    module: blog
+	    fields: [title, body]
+	  - name: author
+	    kind: map
+	    module: blog
+	    index: address
+	    fields: [name, posts:uint]
+	messages:
+	  - name: like-post
+	    module: blog
+	    fields: [id:uint]
+	    response: [likes:uint]
+	queries:
+	  - name: posts-by-author
+	    module: blog
+	    fields: [author]
+	    response: [ids:array.uint]
+	    paginated: true
+	packets:
+	  - name: share-post
+	    module: bridge
+	    fields: [title, body]
+	    ack: [id:uint]
+
+The "kind" of a type is one of "list", "map", "single" or "type". Fields use the
+same notation as the other scaffolding commands, see "ignite scaffold type
+--help" for the supported field types. When no module is specified, the
+component is scaffolded in the app's default module.
+`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldApplyHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
+
+	return c
+}
+
+func scaffoldApplyHandler(cmd *cobra.Command, args []string) error {
+	appPath := flagGetPath(cmd)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	blueprint, err := scaffolder.ParseBlueprintFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if flagGetDryRun(cmd) {
+		sm, diff, tracer, err := sc.PreviewBlueprint(cmd.Context(), blueprint)
+		if err != nil {
+			return err
+		}
+		return printDryRun(session, sm, diff, tracer)
+	}
+
+	sm, err := sc.ApplyBlueprint(cmd.Context(), blueprint)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Blueprint %s applied.\n\n", args[0])
+
+	return nil
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/ignite/cli/v29/ignite/config/chain/defaults"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosgen"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/v29/ignite/pkg/xfilepath"
 	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
 	"github.com/ignite/cli/v29/ignite/pkg/xgit"
@@ -16,6 +18,7 @@ const (
 	flagMinimal         = "minimal"
 	flagNoDefaultModule = "no-module"
 	flagSkipGit         = "skip-git"
+	flagICAHost         = "ica-host"
 
 	tplScaffoldChainSuccess = `
 ⭐️ Successfully created a new blockchain '%[1]v'.
@@ -66,6 +69,15 @@ use a custom address prefix use the "--address-prefix" flag. For example:
 
 	ignite scaffold chain foo --address-prefix bar
 
+The interchain accounts (ICA) host module lets the accounts of counterparty
+chains execute messages on your blockchain. By default it uses the genesis
+params of the IBC module. Use the "--ica-host" flag to enable it with an
+allowlist of messages that interchain accounts are allowed to execute. The
+allowlist is written in the "genesis" section of "config.yml" and can be edited
+before starting the chain:
+
+	ignite scaffold chain foo --ica-host
+
 By default when compiling a blockchain's source code Ignite creates a cache to
 speed up the build process. To clear the cache when building a blockchain use
 the "--clear-cache" flag. It is very unlikely you will ever need to use this
@@ -87,7 +99,9 @@ about Cosmos SDK on https://docs.cosmos.network
 	c.Flags().Bool(flagSkipGit, false, "skip Git repository initialization")
 	c.Flags().Bool(flagSkipProto, false, "skip proto generation")
 	c.Flags().Bool(flagMinimal, false, "create a minimal blockchain (with the minimum required Cosmos SDK modules)")
+	c.Flags().Bool(flagICAHost, false, "enable the interchain accounts host with an allowlist of messages in config.yml")
 	c.Flags().String(flagProtoDir, defaults.ProtoDir, "chain proto directory")
+	c.Flags().AddFlagSet(flagSetDryRun())
 
 	// consumer scaffolding have been migrated to an ignite app
 	_ = c.Flags().MarkDeprecated("consumer", "use 'ignite consumer' app instead")
@@ -107,6 +121,7 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 		noDefaultModule, _ = cmd.Flags().GetBool(flagNoDefaultModule)
 		skipGit, _         = cmd.Flags().GetBool(flagSkipGit)
 		minimal, _         = cmd.Flags().GetBool(flagMinimal)
+		icaHost, _         = cmd.Flags().GetBool(flagICAHost)
 		params, _          = cmd.Flags().GetStringSlice(flagParams)
 		moduleConfigs, _   = cmd.Flags().GetStringSlice(flagModuleConfigs)
 		skipProto, _       = cmd.Flags().GetBool(flagSkipProto)
@@ -121,24 +136,49 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if minimal && icaHost {
+		return errors.New("the interchain accounts host requires IBC, which is not part of minimal chains")
+	}
+
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
 	}
 
 	runner := xgenny.NewRunner(cmd.Context(), appPath)
-	appDir, goModule, err := scaffolder.Init(
-		cmd.Context(),
-		runner,
-		appPath,
-		name,
-		addressPrefix,
-		protoDir,
-		noDefaultModule,
-		minimal,
-		params,
-		moduleConfigs,
-	)
+	initChain := func(root string) (string, string, error) {
+		return scaffolder.Init(
+			cmd.Context(),
+			runner,
+			root,
+			name,
+			addressPrefix,
+			protoDir,
+			noDefaultModule,
+			minimal,
+			icaHost,
+			params,
+			moduleConfigs,
+		)
+	}
+
+	// The chain is scaffolded in a temporary directory that has the layout of the target one
+	if flagGetDryRun(cmd) {
+		target := appPath
+		if target == "" {
+			pathInfo, err := gomodulepath.Parse(name)
+			if err != nil {
+				return err
+			}
+			target = pathInfo.Root
+		}
+		return scaffoldDryRunDir(session, target, runner.Tracer(), func(dir string) error {
+			_, _, err := initChain(dir)
+			return err
+		})
+	}
+
+	appDir, goModule, err := initChain(appPath)
 	if err != nil {
 		return err
 	}
@@ -152,6 +192,10 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if err := cosmosgen.InstallDepTools(cmd.Context(), appDir); err != nil {
+		return err
+	}
+
 	if err := scaffolder.PostScaffold(cmd.Context(), cacheStorage, appDir, protoDir, goModule, skipProto); err != nil {
 		return err
 	}
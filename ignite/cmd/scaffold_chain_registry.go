@@ -31,6 +31,7 @@ Read more about the assets.json at https://github.com/cosmos/chain-registry?tab=
 	flagSetClearCache(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 
 	return c
 }
@@ -55,7 +56,14 @@ func scaffoldChainRegistryFiles(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	if err = sc.AddChainRegistryFiles(c, cfg); err != nil {
+	if flagGetDryRun(cmd) {
+		// the files are written in the current directory
+		return scaffoldDryRunDir(session, ".", nil, func(dir string) error {
+			return sc.AddChainRegistryFiles(c, cfg, dir)
+		})
+	}
+
+	if err = sc.AddChainRegistryFiles(c, cfg, ""); err != nil {
 		return err
 	}
 
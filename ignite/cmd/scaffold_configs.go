@@ -35,6 +35,7 @@ configs.
 	flagSetClearCache(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 
 	c.Flags().String(flagModule, "", "module to add the query into (default: app's main module)")
 
@@ -67,6 +68,9 @@ func scaffoldConfigsHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	err = sc.CreateConfigs(moduleName, configs...)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,106 @@
+package ignitecmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+// NewScaffoldField returns a command to manage the fields of scaffolded types.
+func NewScaffoldField() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "field [command]",
+		Short: "Manage the fields of a scaffolded type",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewScaffoldFieldAdd())
+
+	return c
+}
+
+// NewScaffoldFieldAdd returns a command to add fields to a scaffolded type.
+func NewScaffoldFieldAdd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "add TYPE [field:type]...",
+		Short: "Add fields to a type scaffolded with list, map or single",
+		Long: `Add fields to a type scaffolded with the list, map, single or type commands.
+
+The fields are appended to the proto message of the type with the next free
+field numbers. For types scaffolded with the list, map and single commands,
+the create and update messages, their handlers, the autocli options and the
+genesis tests are updated as well.
+
+For detailed type information use ignite scaffold type --help.`,
+		Example: "  ignite scaffold field add post likes:uint tags:array.string --module blog",
+		Args:    cobra.MinimumNArgs(2),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldFieldAddHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
+	c.Flags().String(flagModule, "", "module of the type. Default: app's main module")
+
+	return c
+}
+
+func scaffoldFieldAddHandler(cmd *cobra.Command, args []string) error {
+	var (
+		typeName   = args[0]
+		fields     = args[1:]
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	err = sc.AddField(cmd.Context(), moduleName, typeName, fields)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications()
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Added %s to %s.\n\n", strings.Join(fields, ", "), typeName)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+const (
+	flagWrap = "wrap"
+)
+
+// NewScaffoldIBCMiddleware returns the command to scaffold an IBC middleware.
+func NewScaffoldIBCMiddleware() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ibc-middleware [name]",
+		Short: "IBC middleware wrapping an existing IBC stack",
+		Long: `Scaffold an IBC middleware that wraps the IBC stack of an existing route of the
+IBC router, like the transfer stack.
+
+The middleware is created in the "x/[name]" package. It implements the ICS26
+callbacks of an IBC module, which are passed to the wrapped application, and the
+ICS4 wrapper used by the application to send its packets. The "onRecvPacket" and
+"onSendPacket" hooks in "hooks.go" are the places to add the logic of the
+middleware, e.g. fees, rate limits or memo processing:
+
+	ignite scaffold ibc-middleware ratelimit --wrap transfer
+
+The middleware is the outermost layer of the stack in the app file that wires the
+IBC modules: it receives the packets before the wrapped stack and is set as the
+ICS4 wrapper of the keeper of the application, it sends the packets through the
+ICS4 wrapper of the wrapped stack. The stacks of the IBC router with an
+application created from its app keeper, like transfer, icacontroller or icahost,
+can be wrapped. Scaffolding several middlewares for the same port stacks them.
+`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldIBCMiddlewareHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
+	c.Flags().String(flagWrap, "transfer", "port of the IBC route to wrap with the middleware")
+
+	return c
+}
+
+func scaffoldIBCMiddlewareHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name    = args[0]
+		appPath = flagGetPath(cmd)
+		port, _ = cmd.Flags().GetString(flagWrap)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	err = sc.AddIBCMiddleware(name, port)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications()
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, true); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Created the IBC middleware `%[1]v` wrapping the %[2]v stack.\n\n", name, port)
+
+	return nil
+}
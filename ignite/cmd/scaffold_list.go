@@ -103,6 +103,7 @@ The "creator" field is not generated if a list is scaffolded with the
 	flagSetClearCache(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
 
 	return c
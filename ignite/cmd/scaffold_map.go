@@ -57,6 +57,7 @@ For detailed type information use ignite scaffold type --help
 	flagSetClearCache(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
 	c.Flags().String(FlagIndexName, "index", "field that index the value")
 
@@ -46,7 +46,15 @@ create and broadcast a transaction with MsgAddPool is created in the module's
 to satisfy the sdk.Msg interface and register the message in the module.
 
 Most importantly in the "keeper" package Ignite scaffolds an "AddPool" function.
-Inside this function, you can implement message handling logic.
+Inside this function, you can implement message handling logic. A "FuzzMsgAddPool"
+fuzz test is scaffolded next to it, seeded with values of the field types, to
+check that decoding and handling any encoded message never panics:
+
+	go test ./x/dex/keeper -run FuzzMsgAddPool -fuzz FuzzMsgAddPool
+
+When the app has the integration test harness, created by the modules scaffolded
+with "--with-integration-tests", a "BenchmarkMsgAddPool" benchmark is scaffolded
+in "x/dex/integration" to track the gas used by the message with "ignite chain bench".
 
 When successfully processed a message can return data. Use the —response flag to
 specify response fields and their types. For example
@@ -69,6 +77,7 @@ for details.
 	flagSetClearCache(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 	c.Flags().String(flagModule, "", "module to add the message into. Default: app's main module")
 	c.Flags().StringSliceP(flagResponse, "r", []string{}, "response fields")
 	c.Flags().Bool(flagNoSimulation, false, "disable CRUD simulation scaffolding")
@@ -124,6 +133,9 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	err = sc.AddMessage(cmd.Context(), module, args[0], args[1:], resFields, options...)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -25,6 +25,8 @@ const (
 	flagModuleConfigs       = "module-configs"
 	flagIBCOrdering         = "ordering"
 	flagRequireRegistration = "require-registration"
+	flagIntegrationTests    = "with-integration-tests"
+	flagICAController       = "ica-controller"
 )
 
 // NewScaffoldModule returns the command to scaffold a Cosmos SDK module.
@@ -86,6 +88,26 @@ you can specify a type for each param. For example:
 
 	ignite scaffold module foo --params baz:uint,bar:bool
 
+To scaffold a module with an integration test suite use the
+"--with-integration-tests" flag. The suite in "x/{module}/integration" boots
+the app in-process with its dependency injection configuration, funds the
+accounts of "config.yml" at genesis, delivers the module messages in
+transactions and runs the module queries end-to-end. The test harness is
+generated in "testutil/integration" and shared by the modules.
+
+	ignite scaffold module foo --with-integration-tests
+
+To execute transactions on counterparty chains with interchain accounts (ICA)
+use the "--ica-controller" flag. The keeper of the module gets methods to
+register the interchain accounts of its owners and to send messages to be
+executed by them on the host chains. The module is set as the authentication
+module of the ICA controller in "app/ibc.go", so the acknowledgements and
+timeouts of the messages are passed to the callbacks of the keeper in
+"x/{module}/keeper/ica.go". Only one module of the app can control the
+interchain accounts.
+
+	ignite scaffold module foo --ica-controller
+
 Refer to Cosmos SDK documentation to learn more about modules, dependencies and
 params.
 `,
@@ -98,12 +120,15 @@ params.
 	flagSetClearCache(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 	c.Flags().StringSlice(flagDep, []string{}, "add a dependency on another module")
 	c.Flags().Bool(flagIBC, false, "add IBC functionality")
 	c.Flags().String(flagIBCOrdering, "none", "channel ordering of the IBC module [none|ordered|unordered]")
 	c.Flags().Bool(flagRequireRegistration, false, "fail if module can't be registered")
 	c.Flags().StringSlice(flagParams, []string{}, "add module parameters")
 	c.Flags().StringSlice(flagModuleConfigs, []string{}, "add module configs")
+	c.Flags().Bool(flagIntegrationTests, false, "add an integration test suite running the module in the app")
+	c.Flags().Bool(flagICAController, false, "register interchain accounts and send messages over ICA")
 
 	return c
 }
@@ -126,6 +151,8 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 	ibcOrdering, _ := cmd.Flags().GetString(flagIBCOrdering)
 	requireRegistration, _ := cmd.Flags().GetBool(flagRequireRegistration)
 	params, _ := cmd.Flags().GetStringSlice(flagParams)
+	integrationTests, _ := cmd.Flags().GetBool(flagIntegrationTests)
+	icaController, _ := cmd.Flags().GetBool(flagICAController)
 
 	moduleConfigs, err := cmd.Flags().GetStringSlice(flagModuleConfigs)
 	if err != nil {
@@ -147,6 +174,14 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.WithIBCChannelOrdering(ibcOrdering), scaffolder.WithIBC())
 	}
 
+	if integrationTests {
+		options = append(options, scaffolder.WithIntegrationTests())
+	}
+
+	if icaController {
+		options = append(options, scaffolder.WithICAController())
+	}
+
 	// Get module dependencies
 	dependencies, _ := cmd.Flags().GetStringSlice(flagDep)
 	if len(dependencies) > 0 {
@@ -177,7 +212,11 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err := sc.CreateModule(name, options...); err != nil {
+	err = sc.CreateModule(name, options...)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
+	if err != nil {
 		var validationErr validation.Error
 		if !requireRegistration && errors.As(err, &validationErr) {
 			fmt.Fprintf(&msg, "Can't register module '%s'.\n", name)
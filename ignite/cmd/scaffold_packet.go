@@ -27,6 +27,7 @@ func NewScaffoldPacket() *cobra.Command {
 	flagSetClearCache(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 	c.Flags().StringSlice(flagAck, []string{}, "custom acknowledgment type (field1,field2,...)")
 	c.Flags().String(flagModule, "", "IBC Module to add the packet into")
 	c.Flags().String(flagSigner, "", "label for the message signer (default: creator)")
@@ -77,6 +78,9 @@ func createPacketHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	err = sc.AddPacket(cmd.Context(), module, packet, packetFields, ackFields, options...)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
 	if err != nil {
 		return err
 	}
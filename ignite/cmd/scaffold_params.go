@@ -37,6 +37,7 @@ params.
 	flagSetClearCache(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 
 	c.Flags().String(flagModule, "", "module to add the query into. Default: app's main module")
 
@@ -69,6 +70,9 @@ func scaffoldParamsHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	err = sc.CreateParams(moduleName, params...)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
 	if err != nil {
 		return err
 	}
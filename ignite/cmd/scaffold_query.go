@@ -11,6 +11,8 @@ import (
 
 const (
 	flagPaginated = "paginated"
+	flagHTTPPath  = "http-path"
+	flagStore     = "store"
 )
 
 // NewScaffoldQuery command creates a new type command to scaffold queries.
@@ -19,7 +21,21 @@ func NewScaffoldQuery() *cobra.Command {
 		Use:   "query [name] [field1:type1] [field2:type2] ...",
 		Short: "Query for fetching data from a blockchain",
 		Long: `Query for fetching data from a blockchain.
-		
+
+By default, the query is exposed on the "/APP/MODULE/QUERY" REST path with the
+request fields appended as path params. Use the "--http-path" flag to define a
+custom REST path template. The path params must be request fields of the query:
+
+  ignite scaffold query post-by-id id:uint --http-path "/blog/posts/{id}"
+
+A query can be bound to a map or a list scaffolded in the module with the
+"--store" flag. The generated query returns the paginated items of the store and
+the request fields are used as filters. A request field named as the index of a
+map filters the items by index prefix, other request fields must be fields of the
+type and filter the items by value. The filters are ignored when empty:
+
+  ignite scaffold query posts-by-title title --store post
+
 For detailed type information use ignite scaffold type --help.`,
 		Args:    cobra.MinimumNArgs(1),
 		PreRunE: migrationPreRunHandler,
@@ -30,10 +46,13 @@ For detailed type information use ignite scaffold type --help.`,
 	flagSetClearCache(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 	c.Flags().String(flagModule, "", "module to add the query into. Default: app's main module")
 	c.Flags().StringSliceP(flagResponse, "r", []string{}, "response fields")
 	c.Flags().StringP(flagDescription, "d", "", "description of the CLI to broadcast a tx with the message")
 	c.Flags().Bool(flagPaginated, false, "define if the request can be paginated")
+	c.Flags().String(flagHTTPPath, "", "REST path template of the query, i.e. \"/blog/posts/{id}\"")
+	c.Flags().String(flagStore, "", "map or list of the module to bind the query to")
 
 	return c
 }
@@ -62,9 +81,19 @@ func queryHandler(cmd *cobra.Command, args []string) error {
 
 	var (
 		paginated, _ = cmd.Flags().GetBool(flagPaginated)
+		httpPath, _  = cmd.Flags().GetString(flagHTTPPath)
+		store, _     = cmd.Flags().GetString(flagStore)
 		appPath      = flagGetPath(cmd)
 	)
 
+	var options []scaffolder.QueryOption
+	if httpPath != "" {
+		options = append(options, scaffolder.QueryWithHTTPPath(httpPath))
+	}
+	if store != "" {
+		options = append(options, scaffolder.QueryWithStore(store))
+	}
+
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
@@ -75,7 +104,10 @@ func queryHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	err = sc.AddQuery(cmd.Context(), module, args[0], desc, args[1:], resFields, paginated)
+	err = sc.AddQuery(cmd.Context(), module, args[0], desc, args[1:], resFields, paginated, options...)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -20,6 +20,7 @@ func NewScaffoldReact() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 	c.Flags().StringP(flagPath, "p", "./"+chainconfig.DefaultReactPath, "path to scaffold content of the React app")
 
 	return c
@@ -30,6 +31,10 @@ func scaffoldReactHandler(cmd *cobra.Command, _ []string) error {
 	defer session.End()
 
 	path := flagGetPath(cmd)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRunDir(session, path, nil, cosmosgen.React)
+	}
+
 	if err := cosmosgen.React(path); err != nil {
 		return err
 	}
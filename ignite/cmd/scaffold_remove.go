@@ -0,0 +1,113 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+	"github.com/ignite/cli/v29/ignite/templates/remove"
+)
+
+// NewScaffoldRemove returns a command to remove scaffolded components.
+func NewScaffoldRemove() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "remove [command]",
+		Short: "Remove a scaffolded component",
+		Long: `Remove a component previously scaffolded with the scaffold commands.
+
+The files created for the component are deleted, the code inserted into the
+existing files of the module is removed and the protocol buffer files are
+regenerated.
+
+Components scaffolded with custom types keep the imports of their custom types
+in the proto files, remove them manually if they are no longer used.
+`,
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		newScaffoldRemoveKind(remove.KindList, "Remove a list scaffolded with \"ignite scaffold list\""),
+		newScaffoldRemoveKind(remove.KindMap, "Remove a map scaffolded with \"ignite scaffold map\""),
+		newScaffoldRemoveKind(remove.KindSingle, "Remove a singleton scaffolded with \"ignite scaffold single\""),
+		newScaffoldRemoveKind(remove.KindMessage, "Remove a message scaffolded with \"ignite scaffold message\""),
+		newScaffoldRemoveKind(remove.KindQuery, "Remove a query scaffolded with \"ignite scaffold query\""),
+		newScaffoldRemoveKind(remove.KindPacket, "Remove a packet scaffolded with \"ignite scaffold packet\""),
+	)
+
+	return c
+}
+
+func newScaffoldRemoveKind(kind remove.Kind, short string) *cobra.Command {
+	c := &cobra.Command{
+		Use:     string(kind) + " NAME",
+		Short:   short,
+		Example: "  ignite scaffold remove " + string(kind) + " post --module blog",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return scaffoldRemoveHandler(cmd, kind, args[0])
+		},
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
+	c.Flags().String(flagModule, "", "module to remove the component from. Default: app's main module")
+
+	return c
+}
+
+func scaffoldRemoveHandler(cmd *cobra.Command, kind remove.Kind, name string) error {
+	var (
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	err = sc.RemoveComponent(cmd.Context(), kind, moduleName, name)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications()
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🗑  %s %s removed.\n\n", kind, name)
+
+	return nil
+}
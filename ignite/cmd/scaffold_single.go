@@ -24,6 +24,7 @@ For detailed type information use ignite scaffold type --help.`,
 	flagSetClearCache(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
 
 	return c
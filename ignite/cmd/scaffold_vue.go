@@ -20,6 +20,7 @@ func NewScaffoldVue() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
 	c.Flags().StringP(flagPath, "p", "./"+chainconfig.DefaultVuePath, "path to scaffold content of the Vue.js app")
 
 	return c
@@ -30,6 +31,10 @@ func scaffoldVueHandler(cmd *cobra.Command, _ []string) error {
 	defer session.End()
 
 	path := flagGetPath(cmd)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRunDir(session, path, nil, cosmosgen.Vue)
+	}
+
 	if err := cosmosgen.Vue(path); err != nil {
 		return err
 	}
@@ -0,0 +1,132 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+	"github.com/ignite/cli/v29/ignite/templates/wasm"
+)
+
+const (
+	flagWasmQueryGasLimit      = "query-gas-limit"
+	flagWasmMemoryCacheSize    = "memory-cache-size"
+	flagWasmSimulationGasLimit = "simulation-gas-limit"
+)
+
+// NewScaffoldWasm returns the command to add the wasm module to the app.
+func NewScaffoldWasm() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "wasm",
+		Short: "CosmWasm smart contracts support",
+		Long: `Add the wasm module to the blockchain to upload and run CosmWasm smart contracts.
+
+The wasm module of wasmd is registered in the app with its store key, the ante
+decorators that limit the gas of the simulations and count the transactions
+for the contracts, and the IBC route of the contracts. The wasm flags are added
+to the start command of the node.
+
+The node settings of the module are added to the validators of config.yml and
+written to their app.toml:
+
+	validators:
+	- name: alice
+	  app:
+	    wasm:
+	      query_gas_limit: 3000000
+	      memory_cache_size: 100
+	      simulation_gas_limit: 50000000
+
+The contracts listed in the "wasm" section of config.yml are uploaded and
+instantiated by "ignite chain serve" once the chain is started, before the
+seed transactions. The address of an instantiated contract replaces the
+${name} placeholders of the seed transactions and of the instantiate messages
+of the next contracts:
+
+	wasm:
+	  contracts:
+	  - name: counter
+	    path: artifacts/counter.wasm
+	    signer: alice
+	    instantiate:
+	      admin: alice
+	      funds: ["10token"]
+	      msg:
+	        count: 0
+
+The wasm module requires IBC, so it can't be added to minimal chains.
+`,
+		Args:    cobra.NoArgs,
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldWasmHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().AddFlagSet(flagSetDryRun())
+	c.Flags().Uint64(flagWasmQueryGasLimit, wasm.DefaultQueryGasLimit, "maximum gas used by the smart queries of the contracts")
+	c.Flags().Uint32(flagWasmMemoryCacheSize, wasm.DefaultMemoryCacheSize, "size of the in-memory cache of the contracts in MiB")
+	c.Flags().Uint64(flagWasmSimulationGasLimit, wasm.DefaultSimulationGasLimit, "maximum gas used by the transaction simulations")
+
+	return c
+}
+
+func scaffoldWasmHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		appPath               = flagGetPath(cmd)
+		queryGasLimit, _      = cmd.Flags().GetUint64(flagWasmQueryGasLimit)
+		memoryCacheSize, _    = cmd.Flags().GetUint32(flagWasmMemoryCacheSize)
+		simulationGasLimit, _ = cmd.Flags().GetUint64(flagWasmSimulationGasLimit)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	err = sc.AddWasm(
+		scaffolder.WasmWithQueryGasLimit(queryGasLimit),
+		scaffolder.WasmWithMemoryCacheSize(memoryCacheSize),
+		scaffolder.WasmWithSimulationGasLimit(simulationGasLimit),
+	)
+	if flagGetDryRun(cmd) {
+		return scaffoldDryRun(session, sc, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications()
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, true); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Added the wasm module to the app.\n\n")
+
+	return nil
+}
@@ -9,13 +9,14 @@ func NewTestnet() *cobra.Command {
 	c := &cobra.Command{
 		Use:     "testnet [command]",
 		Short:   "Simulate and manage test networks",
-		Long:    `Comprehensive toolset for managing and simulating blockchain test networks. It allows users to either run a test network in place using mainnet data or set up a multi-node environment for more complex testing scenarios. Additionally, it includes a subcommand for simulating the chain, which is useful for fuzz testing and other testing-related tasks.`,
+		Long:    `Comprehensive toolset for managing and simulating blockchain test networks. It allows users to either run a test network in place using mainnet data, fork the state of a network into a local test network or set up a multi-node environment for more complex testing scenarios. Additionally, it includes a subcommand for simulating the chain, which is useful for fuzz testing and other testing-related tasks.`,
 		Aliases: []string{"t"},
 		Args:    cobra.ExactArgs(1),
 	}
 
 	c.AddCommand(
 		NewTestnetInPlace(),
+		NewTestnetFork(),
 		NewTestnetMultiNode(),
 		NewChainSimulate(), // While this is not per se a testnet command, it is related to testing.
 	)
@@ -0,0 +1,116 @@
+package ignitecmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+const (
+	flagGenesis  = "genesis"
+	flagSnapshot = "snapshot"
+)
+
+// NewTestnetFork returns a command to serve a testnet created from the state of a network.
+func NewTestnetFork() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "fork",
+		Short: "Fork the state of a network into a local testnet",
+		Long: `The fork command creates a local testnet from the state of a network, for
+example to reproduce a mainnet issue, and serves it with automatic code reloading.
+
+The state of the network is imported into a fresh data directory initialized
+from the config file, and is then turned into a single validator testnet with
+"in-place-testnet": the chain ID is the chain ID of the config, the validator is
+the first validator of the config and the accounts of the config are funded.
+
+The state is either an exported genesis, with a file path or a URL:
+
+	ignite testnet fork --genesis exported_genesis.json
+
+Or the genesis of the network and the snapshot of the data directory of one of
+its nodes, as a tar.gz archive containing the "data" directory:
+
+	ignite testnet fork --genesis genesis.json --snapshot snapshot.tar.gz
+
+An exported genesis is first started with the validator of the testnet in place
+of the validator with the most voting power to commit a block, from which the
+testnet is created.
+
+Once created, the testnet is served like with "ignite chain serve": the state is
+kept by exporting and importing the genesis when the source code is modified.
+`,
+		Args: cobra.NoArgs,
+		RunE: testnetForkHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetConfig())
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetVerbose())
+	c.Flags().String(flagGenesis, "", "path or URL of the genesis of the network")
+	c.Flags().String(flagSnapshot, "", "path of a tar.gz archive of the data directory of a node of the network")
+	c.Flags().Bool(flagGenerateClients, false, "generate code for the configured clients on reset or source code change")
+	c.Flags().Bool(flagQuitOnFail, false, "quit program if the app fails to start")
+	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binary")
+
+	return c
+}
+
+func testnetForkHandler(cmd *cobra.Command, _ []string) error {
+	genesis, _ := cmd.Flags().GetString(flagGenesis)
+	if genesis == "" {
+		return errors.Errorf("--%s is required", flagGenesis)
+	}
+	snapshot, _ := cmd.Flags().GetString(flagSnapshot)
+
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+	)
+	defer session.End()
+
+	chainOption := []chain.Option{
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+		chain.CheckCosmosSDKVersion(),
+	}
+
+	if flagGetCheckDependencies(cmd) {
+		chainOption = append(chainOption, chain.CheckDependencies())
+	}
+
+	// check if custom config is defined
+	config, _ := cmd.Flags().GetString(flagConfig)
+	if config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	options := chainServeOptions(cmd)
+	options = append(options, chain.ServeFork(chain.ForkArgs{
+		Genesis:  genesis,
+		Snapshot: snapshot,
+	}))
+
+	err = c.Serve(cmd.Context(), cacheStorage, options...)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
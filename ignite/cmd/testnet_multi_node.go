@@ -1,8 +1,10 @@
 package ignitecmd
 
 import (
+	"context"
 	"os"
 	"path"
+	"path/filepath"
 	"strconv"
 	"time"
 
@@ -16,12 +18,16 @@ import (
 	v1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
 	"github.com/ignite/cli/v29/ignite/pkg/availableport"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/xfilepath"
 	"github.com/ignite/cli/v29/ignite/services/chain"
 )
 
 const (
 	flagNodeDirPrefix = "node-dir-prefix"
+	flagExport        = "export"
+	flagFaults        = "faults"
+	flagFaultScenario = "fault-scenario"
 )
 
 func NewTestnetMultiNode() *cobra.Command {
@@ -48,6 +54,42 @@ func NewTestnetMultiNode() *cobra.Command {
 			Usage:
 					ignite testnet multi-node [flags]
 
+			To run the same testnet with container tooling, export it as a Docker Compose project
+			instead of running the nodes. The export directory contains the home of each node,
+			the app binary built for Linux, a Dockerfile and a "docker-compose.yml" file:
+
+					ignite testnet multi-node --export ./testnet
+					cd ./testnet && docker compose up
+
+			To validate the liveness of the chain when the network is unreliable, enable the
+			fault injection. The nodes are then connected through local proxies and faults can
+			be injected in a node by pressing a fault key followed by the node number:
+
+					x: partition the node from the other nodes
+					l: add latency and jitter to the connections of the node
+					d: drop the data sent to and from the node
+					p: pause the node process
+					h: heal the network faults of all the nodes
+
+					ignite testnet multi-node --faults
+
+			Faults can also be scripted over block heights with a scenario file, the nodes are
+			numbered from 1 in the same order as the config validators:
+
+					faults:
+					  - height: 10
+					    action: partition   # partition, latency, drop, pause, resume or heal
+					    nodes: [2]
+					  - height: 20
+					    action: latency
+					    nodes: [1, 3]
+					    latency: 500ms
+					    jitter: 100ms
+					  - height: 30
+					    action: heal        # heals all the nodes when no nodes are listed
+
+					ignite testnet multi-node --fault-scenario faults.yml
+
 		`,
 		Args: cobra.NoArgs,
 		RunE: testnetMultiNodeHandler,
@@ -60,6 +102,9 @@ func NewTestnetMultiNode() *cobra.Command {
 	c.Flags().AddFlagSet(flagSetVerbose())
 	c.Flags().BoolP(flagResetOnce, "r", false, "reset the app state once on init")
 	c.Flags().String(flagNodeDirPrefix, "validator", "prefix of dir node")
+	c.Flags().String(flagExport, "", "export the testnet as a Docker Compose project in the directory instead of running it")
+	c.Flags().Bool(flagFaults, false, "connect the nodes through proxies to inject network faults")
+	c.Flags().String(flagFaultScenario, "", "inject the faults of the scenario file over block heights (implies --faults)")
 
 	c.Flags().Bool(flagQuitOnFail, false, "quit program if the app fails to start")
 	return c
@@ -125,6 +170,28 @@ func testnetMultiNode(cmd *cobra.Command, session *cliui.Session) error {
 		ListPorts:             ports,
 	}
 
+	var (
+		faults, _        = cmd.Flags().GetBool(flagFaults)
+		faultScenario, _ = cmd.Flags().GetString(flagFaultScenario)
+	)
+
+	if exportDir, _ := cmd.Flags().GetString(flagExport); exportDir != "" {
+		if faults || faultScenario != "" {
+			return errors.Errorf("--%s can't be used with the fault injection", flagExport)
+		}
+		return testnetMultiNodeExport(cmd, session, c, args, exportDir)
+	}
+
+	var modelOptions []cmdmodel.MultiNodeOption
+	if faultScenario != "" {
+		scenario, err := chain.ParseFaultScenario(faultScenario, numVal)
+		if err != nil {
+			return err
+		}
+		modelOptions = append(modelOptions, cmdmodel.WithFaultScenario(scenario))
+		faults = true
+	}
+
 	resetOnce, _ := cmd.Flags().GetBool(flagResetOnce)
 	if resetOnce {
 		// If resetOnce is true, the app state will be reset by deleting the output directory.
@@ -139,9 +206,25 @@ func testnetMultiNode(cmd *cobra.Command, session *cliui.Session) error {
 		return err
 	}
 
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	if faults {
+		network, err := chain.NewFaultNetwork(args)
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := network.Serve(ctx); err != nil {
+				_ = session.Printf("fault network stopped: %s\n", err)
+			}
+		}()
+		modelOptions = append(modelOptions, cmdmodel.WithFaultNetwork(network))
+	}
+
 	time.Sleep(2 * time.Second)
 
-	model, err := cmdmodel.NewModel(cmd.Context(), c.Name(), args)
+	model, err := cmdmodel.NewModel(ctx, c.Name(), args, modelOptions...)
 	if err != nil {
 		return err
 	}
@@ -150,6 +233,34 @@ func testnetMultiNode(cmd *cobra.Command, session *cliui.Session) error {
 	return err
 }
 
+// testnetMultiNodeExport exports the testnet as a Docker Compose project.
+func testnetMultiNodeExport(
+	cmd *cobra.Command,
+	session *cliui.Session,
+	c *chain.Chain,
+	args chain.MultiNodeArgs,
+	exportDir string,
+) error {
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	if args.OutputDir, err = filepath.Abs(exportDir); err != nil {
+		return err
+	}
+
+	if err := c.ExportMultiNode(cmd.Context(), cacheStorage, args, flagGetSkipProto(cmd)); err != nil {
+		return err
+	}
+
+	return session.Printf(
+		"🗃  Testnet exported to %s\nStart it with: cd %s && docker compose up\n",
+		args.OutputDir,
+		exportDir,
+	)
+}
+
 // getValidatorAmountStake returns the number of validators and the amountStakes arg from config.MultiNode.
 func getValidatorAmountStake(validators []v1.Validator) (int, string, error) {
 	numVal := len(validators)
@@ -1,7 +1,10 @@
 package base
 
 import (
+	"time"
+
 	"github.com/imdario/mergo"
+	"gopkg.in/yaml.v3"
 
 	"github.com/ignite/cli/v29/ignite/config/chain/defaults"
 	"github.com/ignite/cli/v29/ignite/config/chain/version"
@@ -17,6 +20,68 @@ type Account struct {
 	CoinType      string   `yaml:"cointype,omitempty" doc:"Coin type number for HD derivation (default is 118)."`
 	AccountNumber string   `yaml:"account_number,omitempty" doc:"Account number for HD derivation (must be ≤ 2147483647)."`
 	AddressIndex  string   `yaml:"address_index,omitempty" doc:"Address index number for HD derivation (must be ≤ 2147483647)."`
+	Vesting       *Vesting `yaml:"vesting,omitempty" doc:"Vesting schedule of the account coins."`
+	Module        string   `yaml:"module,omitempty" doc:"Name of the module account to fund, the address is derived from the module name."`
+}
+
+// Vesting holds the vesting schedule of an account.
+// The coins vest continuously between the start and end times when both are defined,
+// all at once at the end time when only the end time is defined, or by periods
+// beginning at the start time when periods are defined.
+type Vesting struct {
+	Coins   []string        `yaml:"coins,omitempty" doc:"List of vesting token balances, must not exceed the account coins."`
+	Start   string          `yaml:"start,omitempty" doc:"Start time of the vesting schedule (RFC3339)."`
+	End     string          `yaml:"end,omitempty" doc:"End time of the vesting schedule (RFC3339)."`
+	Periods []VestingPeriod `yaml:"periods,omitempty" doc:"Lists the periods of a periodic vesting schedule."`
+}
+
+// VestingPeriod holds a period of a periodic vesting schedule.
+type VestingPeriod struct {
+	Length string   `yaml:"length" doc:"Length of the period as a duration (e.g. 720h)."`
+	Coins  []string `yaml:"coins" doc:"List of token balances vested at the end of the period."`
+}
+
+// IsPeriodic returns true if the coins vest by periods.
+func (v Vesting) IsPeriodic() bool {
+	return len(v.Periods) > 0
+}
+
+// StartTime returns the start time of the vesting schedule, zero when not defined.
+func (v Vesting) StartTime() (time.Time, error) {
+	return parseVestingTime(v.Start)
+}
+
+// EndTime returns the end time of the vesting schedule, zero when not defined.
+// The end time of a periodic vesting schedule is the end of its last period.
+func (v Vesting) EndTime() (time.Time, error) {
+	if !v.IsPeriodic() {
+		return parseVestingTime(v.End)
+	}
+
+	end, err := v.StartTime()
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, p := range v.Periods {
+		length, err := p.Duration()
+		if err != nil {
+			return time.Time{}, err
+		}
+		end = end.Add(length)
+	}
+	return end, nil
+}
+
+// Duration returns the length of the vesting period.
+func (p VestingPeriod) Duration() (time.Duration, error) {
+	return time.ParseDuration(p.Length)
+}
+
+func parseVestingTime(t string) (time.Time, error) {
+	if t == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, t)
 }
 
 // Build holds build configs.
@@ -69,6 +134,9 @@ type Hooks struct {
 // OpenAPI configures OpenAPI spec generation for API.
 type OpenAPI struct {
 	Path string `yaml:"path" doc:"Relative path where the application's OpenAPI files are located."`
+
+	// V3Path configures out location for the OpenAPI 3.1 spec generated from the Swagger 2.0 one.
+	V3Path string `yaml:"v3_path,omitempty" doc:"Relative path where the application's OpenAPI 3.1 spec is generated in addition to the Swagger 2.0 spec."`
 }
 
 // Faucet configuration.
@@ -142,10 +210,16 @@ type Config struct {
 	Accounts   []Account       `yaml:"accounts" doc:"Lists the options for setting up Cosmos Accounts."`
 	Faucet     Faucet          `yaml:"faucet,omitempty" doc:"Configuration for the faucet."`
 	Client     Client          `yaml:"client,omitempty" doc:"Configures client code generation."`
-	Genesis    xyaml.Map       `yaml:"genesis,omitempty" doc:"Custom genesis block modifications. Follow the nesting of the genesis file here to access all the parameters."`
+	Genesis    xyaml.Map       `yaml:"genesis,omitempty" doc:"Custom genesis block modifications. Follow the nesting of the genesis file here to access all the parameters. The fixtures key lists files with the genesis state of modules."`
 	Minimal    bool            `yaml:"minimal,omitempty" doc:"Indicates if the blockchain is minimal with the required Cosmos SDK modules."`
 }
 
+// GenesisFixtures returns the fixture files listed in the genesis config.
+func (c Config) GenesisFixtures() ([]GenesisFixture, error) {
+	_, fixtures, err := SplitGenesisFixtures(c.Genesis)
+	return fixtures, err
+}
+
 // GetVersion returns the config version.
 func (c Config) GetVersion() version.Version {
 	return c.Version
@@ -182,3 +256,38 @@ func DefaultConfig() Config {
 		},
 	}
 }
+
+// GenesisFixturesKey is the key of the genesis config that lists the genesis fixture files.
+const GenesisFixturesKey = "fixtures"
+
+// GenesisFixture is a JSON or YAML file holding the genesis state of a module.
+type GenesisFixture struct {
+	Module string `yaml:"module" doc:"Name of the module."`
+	Path   string `yaml:"path" doc:"Path of the JSON or YAML file, relative to the app path."`
+}
+
+// SplitGenesisFixtures returns a copy of the genesis config without
+// the fixtures key, and the fixture files listed by this key.
+func SplitGenesisFixtures(genesis map[string]interface{}) (map[string]interface{}, []GenesisFixture, error) {
+	raw, ok := genesis[GenesisFixturesKey]
+	if !ok {
+		return genesis, nil, nil
+	}
+
+	out, err := yaml.Marshal(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	var fixtures []GenesisFixture
+	if err := yaml.Unmarshal(out, &fixtures); err != nil {
+		return nil, nil, err
+	}
+
+	data := make(map[string]interface{}, len(genesis))
+	for k, v := range genesis {
+		if k != GenesisFixturesKey {
+			data[k] = v
+		}
+	}
+	return data, fixtures, nil
+}
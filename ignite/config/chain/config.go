@@ -58,6 +58,12 @@ type (
 
 	// Validator defines the latest validator settings.
 	Validator = v1.Validator
+
+	// SeedTx defines the latest seed transaction settings.
+	SeedTx = v1.SeedTx
+
+	// WasmContract defines the latest wasm contract settings.
+	WasmContract = v1.WasmContract
 )
 
 // DefaultChainConfig returns a config for the latest version initialized with default values.
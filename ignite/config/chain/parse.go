@@ -153,6 +153,22 @@ func validateConfig(c *Config) error {
 		}
 	}
 
+	if err := c.ValidateAccounts(); err != nil {
+		return &ValidationError{err.Error()}
+	}
+
+	if err := c.ValidateGenesisFixtures(); err != nil {
+		return &ValidationError{err.Error()}
+	}
+
+	if err := c.ValidateSeed(); err != nil {
+		return &ValidationError{err.Error()}
+	}
+
+	if err := c.ValidateWasm(); err != nil {
+		return &ValidationError{err.Error()}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// ValidateAccounts checks that the vesting schedules and the module accounts are valid.
+func (c *Config) ValidateAccounts() error {
+	for _, account := range c.Accounts {
+		if err := validateAccount(account); err != nil {
+			return errors.Errorf("account %s: %w", account.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateAccount(account base.Account) error {
+	if account.Module != "" {
+		switch {
+		case account.Address != "" || account.Mnemonic != "":
+			return errors.New("a module account can't have an address or a mnemonic")
+		case account.Vesting != nil:
+			return errors.New("a module account can't have a vesting schedule")
+		case len(account.Coins) == 0:
+			return errors.New("a module account must have coins")
+		}
+	}
+
+	if account.Vesting == nil {
+		return nil
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(strings.Join(account.Coins, ","))
+	if err != nil {
+		return errors.Errorf("invalid coins: %w", err)
+	}
+
+	return validateVesting(*account.Vesting, coins)
+}
+
+func validateVesting(v base.Vesting, coins sdk.Coins) error {
+	start, err := v.StartTime()
+	if err != nil {
+		return errors.Errorf("invalid vesting start time: %w", err)
+	}
+
+	var vestingCoins sdk.Coins
+	if v.IsPeriodic() {
+		switch {
+		case start.IsZero():
+			return errors.New("a periodic vesting schedule must have a start time")
+		case v.End != "" || len(v.Coins) > 0:
+			return errors.New("the end time and coins of a periodic vesting schedule are defined by its periods")
+		}
+
+		for i, p := range v.Periods {
+			length, err := p.Duration()
+			if err != nil {
+				return errors.Errorf("invalid length of vesting period %d: %w", i+1, err)
+			}
+			if length <= 0 {
+				return errors.Errorf("the length of vesting period %d must be positive", i+1)
+			}
+			periodCoins, err := sdk.ParseCoinsNormalized(strings.Join(p.Coins, ","))
+			if err != nil {
+				return errors.Errorf("invalid coins of vesting period %d: %w", i+1, err)
+			}
+			vestingCoins = vestingCoins.Add(periodCoins...)
+		}
+	} else {
+		end, err := v.EndTime()
+		switch {
+		case err != nil:
+			return errors.Errorf("invalid vesting end time: %w", err)
+		case end.IsZero():
+			return errors.New("a vesting schedule must have an end time")
+		case !start.IsZero() && !end.After(start):
+			return errors.New("the vesting end time must be after the start time")
+		}
+
+		vestingCoins, err = sdk.ParseCoinsNormalized(strings.Join(v.Coins, ","))
+		if err != nil {
+			return errors.Errorf("invalid vesting coins: %w", err)
+		}
+	}
+
+	if vestingCoins.IsZero() {
+		return errors.New("a vesting schedule must have coins")
+	}
+	if !vestingCoins.IsAllLTE(coins) {
+		return errors.Errorf("the vesting coins %s exceed the account coins %s", vestingCoins, coins)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	v1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
+)
+
+func TestConfigValidateAccounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		account base.Account
+		err     string
+	}{
+		{
+			name: "account without vesting",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"1000token"},
+			},
+		},
+		{
+			name: "continuous vesting",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"1000token"},
+				Vesting: &base.Vesting{
+					Coins: []string{"500token"},
+					Start: "2025-01-01T00:00:00Z",
+					End:   "2026-01-01T00:00:00Z",
+				},
+			},
+		},
+		{
+			name: "delayed vesting",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"1000token"},
+				Vesting: &base.Vesting{
+					Coins: []string{"1000token"},
+					End:   "2026-01-01T00:00:00Z",
+				},
+			},
+		},
+		{
+			name: "periodic vesting",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"1000token"},
+				Vesting: &base.Vesting{
+					Start: "2025-01-01T00:00:00Z",
+					Periods: []base.VestingPeriod{
+						{Length: "720h", Coins: []string{"500token"}},
+						{Length: "720h", Coins: []string{"500token"}},
+					},
+				},
+			},
+		},
+		{
+			name: "module account",
+			account: base.Account{
+				Name:   "community",
+				Coins:  []string{"1000token"},
+				Module: "distribution",
+			},
+		},
+		{
+			name: "vesting without end time",
+			account: base.Account{
+				Name:    "alice",
+				Coins:   []string{"1000token"},
+				Vesting: &base.Vesting{Coins: []string{"500token"}},
+			},
+			err: "account alice: a vesting schedule must have an end time",
+		},
+		{
+			name: "vesting end before start",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"1000token"},
+				Vesting: &base.Vesting{
+					Coins: []string{"500token"},
+					Start: "2026-01-01T00:00:00Z",
+					End:   "2025-01-01T00:00:00Z",
+				},
+			},
+			err: "account alice: the vesting end time must be after the start time",
+		},
+		{
+			name: "vesting coins exceed account coins",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"1000token"},
+				Vesting: &base.Vesting{
+					Coins: []string{"2000token"},
+					End:   "2026-01-01T00:00:00Z",
+				},
+			},
+			err: "account alice: the vesting coins 2000token exceed the account coins 1000token",
+		},
+		{
+			name: "periodic vesting without start time",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"1000token"},
+				Vesting: &base.Vesting{
+					Periods: []base.VestingPeriod{{Length: "720h", Coins: []string{"500token"}}},
+				},
+			},
+			err: "account alice: a periodic vesting schedule must have a start time",
+		},
+		{
+			name: "invalid vesting period length",
+			account: base.Account{
+				Name:  "alice",
+				Coins: []string{"1000token"},
+				Vesting: &base.Vesting{
+					Start:   "2025-01-01T00:00:00Z",
+					Periods: []base.VestingPeriod{{Length: "-1h", Coins: []string{"500token"}}},
+				},
+			},
+			err: "account alice: the length of vesting period 1 must be positive",
+		},
+		{
+			name: "module account with address",
+			account: base.Account{
+				Name:    "community",
+				Coins:   []string{"1000token"},
+				Address: "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw",
+				Module:  "distribution",
+			},
+			err: "account community: a module account can't have an address or a mnemonic",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := v1.DefaultConfig()
+			cfg.Accounts = []base.Account{tt.account}
+
+			err := cfg.ValidateAccounts()
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -25,6 +25,8 @@ type Config struct {
 	base.Config `yaml:",inline"`
 
 	Validators []Validator `yaml:"validators" doc:"Contains information related to the list of validators and settings."`
+	Seed       []SeedTx    `yaml:"seed,omitempty" doc:"Lists the transactions broadcasted to seed the chain state once it is started."`
+	Wasm       *Wasm       `yaml:"wasm,omitempty" doc:"Contains the CosmWasm settings of the chain."`
 }
 
 func (c *Config) SetDefaults() error {
@@ -45,10 +47,11 @@ func (c *Config) Decode(r io.Reader) error {
 	return yaml.NewDecoder(r).Decode(c)
 }
 
-func (c *Config) updateValidatorAddresses() (err error) {
-	// Margin to increase port numbers of the default addresses
-	margin := 10
+// validatorPortMargin is the margin to increase the port numbers
+// of the default addresses for each validator.
+const validatorPortMargin = 10
 
+func (c *Config) updateValidatorAddresses() (err error) {
 	for i := range c.Validators {
 		// Use default addresses for the first validator
 		if i == 0 {
@@ -60,7 +63,7 @@ func (c *Config) updateValidatorAddresses() (err error) {
 		if err != nil {
 			return err
 		}
-		portIncrement := margin * i
+		portIncrement := validatorPortMargin * i
 		if portIncrement < 0 {
 			return fmt.Errorf("calculated port increment is negative: %d", portIncrement) //nolint: forbidigo
 		}
@@ -78,48 +81,92 @@ func (c *Config) updateValidatorAddresses() (err error) {
 	return nil
 }
 
+// IncrementDefaultServerPortsBy increments the ports of the validator and faucet
+// addresses that are not defined in the config. It allows to run the chain next
+// to other chains without port clashing. The defined addresses are kept as is.
+func (c *Config) IncrementDefaultServerPortsBy(inc uint64) error {
+	for i := range c.Validators {
+		validator := &c.Validators[i]
+		servers, err := validator.GetServers()
+		if err != nil {
+			return err
+		}
+
+		// The default addresses of a validator are incremented by its position
+		base, err := incrementDefaultServerPortsBy(DefaultServers(), uint64(validatorPortMargin*i)) //nolint:gosec // index is positive
+		if err != nil {
+			return err
+		}
+
+		servers, err = incrementServerPortsBy(servers, base, inc)
+		if err != nil {
+			return err
+		}
+
+		if err := validator.SetServers(servers); err != nil {
+			return err
+		}
+	}
+
+	if c.Faucet.Port == 0 && c.Faucet.Host == defaults.FaucetHost {
+		host, err := xnet.IncreasePortBy(defaults.FaucetHost, inc)
+		if err != nil {
+			return err
+		}
+		c.Faucet.Host = host
+	}
+
+	return nil
+}
+
 // Returns a new server where the default addresses have their ports
 // incremented by a margin to avoid port clashing.
 func incrementDefaultServerPortsBy(s Servers, inc uint64) (Servers, error) {
+	return incrementServerPortsBy(s, DefaultServers(), inc)
+}
+
+// Returns a new server where the addresses equal to the base addresses
+// have their ports incremented by a margin.
+func incrementServerPortsBy(s, base Servers, inc uint64) (Servers, error) {
 	var err error
 
-	if s.GRPC.Address == defaults.GRPCAddress {
-		s.GRPC.Address, err = xnet.IncreasePortBy(defaults.GRPCAddress, inc)
+	if s.GRPC.Address == base.GRPC.Address {
+		s.GRPC.Address, err = xnet.IncreasePortBy(base.GRPC.Address, inc)
 		if err != nil {
 			return Servers{}, err
 		}
 	}
 
-	if s.GRPCWeb.Address == defaults.GRPCWebAddress {
-		s.GRPCWeb.Address, err = xnet.IncreasePortBy(defaults.GRPCWebAddress, inc)
+	if s.GRPCWeb.Address == base.GRPCWeb.Address {
+		s.GRPCWeb.Address, err = xnet.IncreasePortBy(base.GRPCWeb.Address, inc)
 		if err != nil {
 			return Servers{}, err
 		}
 	}
 
-	if s.API.Address == defaults.APIAddress {
-		s.API.Address, err = xnet.IncreasePortBy(defaults.APIAddress, inc)
+	if s.API.Address == base.API.Address {
+		s.API.Address, err = xnet.IncreasePortBy(base.API.Address, inc)
 		if err != nil {
 			return Servers{}, err
 		}
 	}
 
-	if s.P2P.Address == defaults.P2PAddress {
-		s.P2P.Address, err = xnet.IncreasePortBy(defaults.P2PAddress, inc)
+	if s.P2P.Address == base.P2P.Address {
+		s.P2P.Address, err = xnet.IncreasePortBy(base.P2P.Address, inc)
 		if err != nil {
 			return Servers{}, err
 		}
 	}
 
-	if s.RPC.Address == defaults.RPCAddress {
-		s.RPC.Address, err = xnet.IncreasePortBy(defaults.RPCAddress, inc)
+	if s.RPC.Address == base.RPC.Address {
+		s.RPC.Address, err = xnet.IncreasePortBy(base.RPC.Address, inc)
 		if err != nil {
 			return Servers{}, err
 		}
 	}
 
-	if s.RPC.PProfAddress == defaults.PProfAddress {
-		s.RPC.PProfAddress, err = xnet.IncreasePortBy(defaults.PProfAddress, inc)
+	if s.RPC.PProfAddress == base.RPC.PProfAddress {
+		s.RPC.PProfAddress, err = xnet.IncreasePortBy(base.RPC.PProfAddress, inc)
 		if err != nil {
 			return Servers{}, err
 		}
@@ -229,6 +229,46 @@ func TestConfigValidatorsDefaultServers(t *testing.T) {
 	require.Equal(t, xnet.MustIncreasePortBy(defaults.PProfAddress, inc), servers.RPC.PProfAddress)
 }
 
+func TestConfigIncrementDefaultServerPortsBy(t *testing.T) {
+	// Arrange
+	c := v1.Config{
+		Validators: []v1.Validator{
+			{
+				Name:   "name-1",
+				Bonded: "100ATOM",
+				App: map[string]interface{}{
+					"grpc": map[string]interface{}{"address": "127.0.0.1:8080"},
+				},
+			},
+			{
+				Name:   "name-2",
+				Bonded: "200ATOM",
+			},
+		},
+	}
+	require.NoError(t, c.SetDefaults())
+
+	// Act
+	err := c.IncrementDefaultServerPortsBy(100)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "0.0.0.0:4600", c.Faucet.Host)
+
+	// Assert: The addresses defined in the config are kept
+	servers, err := c.Validators[0].GetServers()
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1:8080", servers.GRPC.Address)
+	require.Equal(t, xnet.MustIncreasePortBy(defaults.RPCAddress, 100), servers.RPC.Address)
+
+	// Assert: The default addresses of the second validator are incremented twice
+	servers, err = c.Validators[1].GetServers()
+	require.NoError(t, err)
+	require.Equal(t, xnet.MustIncreasePortBy(defaults.GRPCAddress, 110), servers.GRPC.Address)
+	require.Equal(t, xnet.MustIncreasePortBy(defaults.RPCAddress, 110), servers.RPC.Address)
+	require.Equal(t, xnet.MustIncreasePortBy(defaults.PProfAddress, 110), servers.RPC.PProfAddress)
+}
+
 func TestClone(t *testing.T) {
 	// Arrange
 	c := &v1.Config{
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// ValidateGenesisFixtures checks that the fixture files of the genesis config are valid.
+func (c *Config) ValidateGenesisFixtures() error {
+	fixtures, err := c.GenesisFixtures()
+	if err != nil {
+		return errors.Errorf("invalid genesis fixtures: %w", err)
+	}
+
+	for i, f := range fixtures {
+		switch {
+		case f.Module == "":
+			return errors.Errorf("genesis fixture %d: a module is required", i+1)
+		case f.Path == "":
+			return errors.Errorf("genesis fixture %d: a path is required", i+1)
+		}
+
+		switch filepath.Ext(f.Path) {
+		case ".json", ".yml", ".yaml":
+		default:
+			return errors.Errorf("genesis fixture %s: the file must be a JSON or YAML file", f.Path)
+		}
+	}
+	return nil
+}
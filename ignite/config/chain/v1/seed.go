@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+// SeedTx is a transaction broadcasted once the chain is started to seed its state.
+type SeedTx struct {
+	// Signer is the name of the account that signs the transaction.
+	Signer string `yaml:"signer" doc:"Name of the account that signs the transaction."`
+
+	// Type is the type URL of the transaction message.
+	Type string `yaml:"type" doc:"Type URL of the transaction message (e.g. /cosmos.bank.v1beta1.MsgSend)."`
+
+	// Body is the JSON body of the transaction message.
+	// The ${name} placeholders are replaced by the address of the account with the name.
+	Body xyaml.Map `yaml:"body" doc:"JSON body of the transaction message, ${name} is replaced by the address of the account name."`
+}
+
+// ValidateSeed checks that the seed transactions are valid.
+func (c *Config) ValidateSeed() error {
+	for i, tx := range c.Seed {
+		if err := c.validateSeedTx(tx); err != nil {
+			return errors.Errorf("seed transaction %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateSeedTx(tx SeedTx) error {
+	if !strings.HasPrefix(tx.Type, "/") {
+		return errors.Errorf("invalid message type URL %q", tx.Type)
+	}
+
+	return c.validateSigner(tx.Signer)
+}
+
+// validateSigner checks that the signer is an account of the config with a key.
+func (c *Config) validateSigner(signer string) error {
+	for _, account := range c.Accounts {
+		if account.Name != signer {
+			continue
+		}
+		if account.Address != "" || account.Module != "" {
+			return errors.Errorf("the signer %s must be an account with a key", signer)
+		}
+		return nil
+	}
+	return errors.Errorf("the signer %s is not an account of the config", signer)
+}
@@ -0,0 +1,57 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	v1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
+)
+
+func TestConfigValidateSeed(t *testing.T) {
+	accounts := []base.Account{
+		{Name: "alice", Coins: []string{"1000token"}},
+		{Name: "bob", Coins: []string{"1000token"}, Address: "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw"},
+	}
+
+	tests := []struct {
+		name string
+		tx   v1.SeedTx
+		err  string
+	}{
+		{
+			name: "valid transaction",
+			tx:   v1.SeedTx{Signer: "alice", Type: "/cosmos.bank.v1beta1.MsgSend"},
+		},
+		{
+			name: "invalid type URL",
+			tx:   v1.SeedTx{Signer: "alice", Type: "cosmos.bank.v1beta1.MsgSend"},
+			err:  `seed transaction 1: invalid message type URL "cosmos.bank.v1beta1.MsgSend"`,
+		},
+		{
+			name: "unknown signer",
+			tx:   v1.SeedTx{Signer: "carol", Type: "/cosmos.bank.v1beta1.MsgSend"},
+			err:  "seed transaction 1: the signer carol is not an account of the config",
+		},
+		{
+			name: "signer without key",
+			tx:   v1.SeedTx{Signer: "bob", Type: "/cosmos.bank.v1beta1.MsgSend"},
+			err:  "seed transaction 1: the signer bob must be an account with a key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := v1.DefaultConfig()
+			cfg.Accounts = accounts
+			cfg.Seed = []v1.SeedTx{tt.tx}
+
+			err := cfg.ValidateSeed()
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+// Wasm holds the CosmWasm settings of the chain.
+type Wasm struct {
+	// Contracts are the contracts uploaded once the chain is started.
+	Contracts []WasmContract `yaml:"contracts,omitempty" doc:"Lists the contracts uploaded and instantiated once the chain is started."`
+}
+
+// WasmContract is a contract uploaded once the chain is started.
+type WasmContract struct {
+	// Name is the name of the contract.
+	// The ${name} placeholders are replaced by the address of the contract once it is instantiated.
+	Name string `yaml:"name" doc:"Name of the contract, ${name} is replaced by the address of the contract in the seed transactions."`
+
+	// Path is the path of the contract wasm file, relative to the app.
+	Path string `yaml:"path" doc:"Path of the contract wasm file, relative to the app."`
+
+	// Signer is the name of the account that uploads and instantiates the contract.
+	Signer string `yaml:"signer" doc:"Name of the account that uploads and instantiates the contract."`
+
+	// Instantiate instantiates the contract once it is uploaded.
+	Instantiate *WasmInstantiate `yaml:"instantiate,omitempty" doc:"Instantiates the contract once it is uploaded."`
+}
+
+// WasmInstantiate holds the settings to instantiate a contract.
+type WasmInstantiate struct {
+	// Label is the label of the contract, the name of the contract is used by default.
+	Label string `yaml:"label,omitempty" doc:"Label of the contract, the name of the contract is used by default."`
+
+	// Admin is the name of the account that can migrate the contract.
+	Admin string `yaml:"admin,omitempty" doc:"Name of the account that can migrate the contract."`
+
+	// Funds are the coins sent to the contract when it is instantiated.
+	Funds []string `yaml:"funds,omitempty" doc:"Coins sent to the contract when it is instantiated."`
+
+	// Msg is the JSON instantiate message of the contract.
+	// The ${name} placeholders are replaced by the address of the account or contract with the name.
+	Msg xyaml.Map `yaml:"msg" doc:"JSON instantiate message of the contract, ${name} is replaced by the address of the account or contract name."`
+}
+
+// ValidateWasm checks that the wasm contracts are valid.
+func (c *Config) ValidateWasm() error {
+	if c.Wasm == nil {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	for _, account := range c.Accounts {
+		names[account.Name] = true
+	}
+
+	for i, contract := range c.Wasm.Contracts {
+		if contract.Name == "" {
+			return errors.Errorf("wasm contract %d: the name is required", i+1)
+		}
+		if names[contract.Name] {
+			return errors.Errorf("wasm contract %s: the name is already used by an account or a contract", contract.Name)
+		}
+		names[contract.Name] = true
+
+		if err := c.validateWasmContract(contract); err != nil {
+			return errors.Errorf("wasm contract %s: %w", contract.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateWasmContract(contract WasmContract) error {
+	if filepath.Ext(contract.Path) != ".wasm" {
+		return errors.Errorf("invalid contract path %q, a .wasm file is expected", contract.Path)
+	}
+
+	if err := c.validateSigner(contract.Signer); err != nil {
+		return err
+	}
+
+	if contract.Instantiate == nil || contract.Instantiate.Admin == "" {
+		return nil
+	}
+	for _, account := range c.Accounts {
+		if account.Name == contract.Instantiate.Admin {
+			return nil
+		}
+	}
+	return errors.Errorf("the admin %s is not an account of the config", contract.Instantiate.Admin)
+}
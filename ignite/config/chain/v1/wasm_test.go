@@ -0,0 +1,88 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	v1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
+)
+
+func TestConfigValidateWasm(t *testing.T) {
+	accounts := []base.Account{
+		{Name: "alice", Coins: []string{"1000token"}},
+		{Name: "bob", Coins: []string{"1000token"}, Address: "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw"},
+	}
+
+	tests := []struct {
+		name      string
+		contracts []v1.WasmContract
+		err       string
+	}{
+		{
+			name: "valid contracts",
+			contracts: []v1.WasmContract{
+				{Name: "cw20", Path: "contracts/cw20.wasm", Signer: "alice"},
+				{
+					Name:        "counter",
+					Path:        "contracts/counter.wasm",
+					Signer:      "alice",
+					Instantiate: &v1.WasmInstantiate{Admin: "bob"},
+				},
+			},
+		},
+		{
+			name:      "missing name",
+			contracts: []v1.WasmContract{{Path: "contracts/cw20.wasm", Signer: "alice"}},
+			err:       "wasm contract 1: the name is required",
+		},
+		{
+			name: "duplicated name",
+			contracts: []v1.WasmContract{
+				{Name: "cw20", Path: "contracts/cw20.wasm", Signer: "alice"},
+				{Name: "cw20", Path: "contracts/cw20.wasm", Signer: "alice"},
+			},
+			err: "wasm contract cw20: the name is already used by an account or a contract",
+		},
+		{
+			name:      "name of an account",
+			contracts: []v1.WasmContract{{Name: "bob", Path: "contracts/cw20.wasm", Signer: "alice"}},
+			err:       "wasm contract bob: the name is already used by an account or a contract",
+		},
+		{
+			name:      "invalid path",
+			contracts: []v1.WasmContract{{Name: "cw20", Path: "contracts/cw20", Signer: "alice"}},
+			err:       `wasm contract cw20: invalid contract path "contracts/cw20", a .wasm file is expected`,
+		},
+		{
+			name:      "signer without key",
+			contracts: []v1.WasmContract{{Name: "cw20", Path: "contracts/cw20.wasm", Signer: "bob"}},
+			err:       "wasm contract cw20: the signer bob must be an account with a key",
+		},
+		{
+			name: "unknown admin",
+			contracts: []v1.WasmContract{{
+				Name:        "cw20",
+				Path:        "contracts/cw20.wasm",
+				Signer:      "alice",
+				Instantiate: &v1.WasmInstantiate{Admin: "carol"},
+			}},
+			err: "wasm contract cw20: the admin carol is not an account of the config",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := v1.DefaultConfig()
+			cfg.Accounts = accounts
+			cfg.Wasm = &v1.Wasm{Contracts: tt.contracts}
+
+			err := cfg.ValidateWasm()
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
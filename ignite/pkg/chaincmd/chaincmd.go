@@ -23,6 +23,7 @@ const (
 	commandValidateGenesis   = "validate"
 	commandExportGenssis     = "export"
 	commandShowNodeID        = "show-node-id"
+	commandShowValidator     = "show-validator"
 	commandStatus            = "status"
 	commandTx                = "tx"
 	commandQuery             = "query"
@@ -50,11 +51,15 @@ const (
 	optionValidatorIdentity                = "--identity"
 	optionValidatorWebsite                 = "--website"
 	optionValidatorSecurityContact         = "--security-contact"
+	optionValidatorPubKey                  = "--pubkey"
+	optionValidatorNodeID                  = "--node-id"
 	optionYes                              = "--yes"
 	optionHomeClient                       = "--home-client"
 	optionCoinType                         = "--coin-type"
 	optionVestingAmount                    = "--vesting-amount"
 	optionVestingEndTime                   = "--vesting-end-time"
+	optionVestingStartTime                 = "--vesting-start-time"
+	optionModuleName                       = "--module-name"
 	optionBroadcastMode                    = "--broadcast-mode"
 	optionAccount                          = "--account"
 	optionIndex                            = "--index"
@@ -293,8 +298,28 @@ func (c ChainCmd) AddGenesisAccountCommand(address, coins string) step.Option {
 	return c.daemonCommand(command)
 }
 
+// VestingOption for the AddVestingAccountCommand.
+type VestingOption func([]string) []string
+
+// VestingWithStartTime provides the vesting start time option for the add vesting account command.
+// When a start time is set the account is a continuous vesting account instead of a delayed one.
+func VestingWithStartTime(vestingStartTime int64) VestingOption {
+	return func(command []string) []string {
+		if vestingStartTime > 0 {
+			return append(command, optionVestingStartTime, fmt.Sprintf("%d", vestingStartTime))
+		}
+		return command
+	}
+}
+
 // AddVestingAccountCommand returns the command to add a delayed vesting account in the genesis file of the chain.
-func (c ChainCmd) AddVestingAccountCommand(address, originalCoins, vestingCoins string, vestingEndTime int64) step.Option {
+func (c ChainCmd) AddVestingAccountCommand(
+	address,
+	originalCoins,
+	vestingCoins string,
+	vestingEndTime int64,
+	options ...VestingOption,
+) step.Option {
 	command := []string{
 		commandGenesis,
 		commandAddGenesisAccount,
@@ -306,6 +331,25 @@ func (c ChainCmd) AddVestingAccountCommand(address, originalCoins, vestingCoins
 		fmt.Sprintf("%d", vestingEndTime),
 	}
 
+	// Apply the options provided by the user
+	for _, apply := range options {
+		command = apply(command)
+	}
+
+	return c.daemonCommand(command)
+}
+
+// AddModuleAccountCommand returns the command to add a module account in the genesis file of the chain.
+func (c ChainCmd) AddModuleAccountCommand(address, coins, moduleName string) step.Option {
+	command := []string{
+		commandGenesis,
+		commandAddGenesisAccount,
+		address,
+		coins,
+		optionModuleName,
+		moduleName,
+	}
+
 	return c.daemonCommand(command)
 }
 
@@ -412,6 +456,28 @@ func GentxWithSecurityContact(securityContact string) GentxOption {
 	}
 }
 
+// GentxWithPubKey provides the consensus public key option for the gentx command.
+// The key of the node home is used when the option is not provided.
+func GentxWithPubKey(pubKey string) GentxOption {
+	return func(command []string) []string {
+		if len(pubKey) > 0 {
+			return append(command, optionValidatorPubKey, pubKey)
+		}
+		return command
+	}
+}
+
+// GentxWithNodeID provides the node ID option for the gentx command.
+// The ID of the node home is used when the option is not provided.
+func GentxWithNodeID(nodeID string) GentxOption {
+	return func(command []string) []string {
+		if len(nodeID) > 0 {
+			return append(command, optionValidatorNodeID, nodeID)
+		}
+		return command
+	}
+}
+
 func (c ChainCmd) IsAutoChainIDDetectionEnabled() bool {
 	return c.isAutoChainIDDetectionEnabled
 }
@@ -483,6 +549,15 @@ func (c ChainCmd) ShowNodeIDCommand() step.Option {
 	return c.daemonCommand(command)
 }
 
+// ShowValidatorCommand returns the command to print the consensus public key of the node for the chain.
+func (c ChainCmd) ShowValidatorCommand() step.Option {
+	command := []string{
+		constTendermint,
+		commandShowValidator,
+	}
+	return c.daemonCommand(command)
+}
+
 // UnsafeResetCommand returns the command to reset the blockchain database.
 func (c ChainCmd) UnsafeResetCommand() step.Option {
 	var command []string
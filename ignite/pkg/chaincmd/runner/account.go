@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
 	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
@@ -221,6 +222,18 @@ func (r Runner) AddVestingAccount(
 	originalCoins,
 	vestingCoins string,
 	vestingEndTime int64,
+	options ...chaincmd.VestingOption,
 ) error {
-	return r.run(ctx, runOptions{}, r.chainCmd.AddVestingAccountCommand(address, originalCoins, vestingCoins, vestingEndTime))
+	return r.run(ctx, runOptions{}, r.chainCmd.AddVestingAccountCommand(
+		address,
+		originalCoins,
+		vestingCoins,
+		vestingEndTime,
+		options...,
+	))
+}
+
+// AddModuleAccount adds a module account to genesis with the name of the module.
+func (r Runner) AddModuleAccount(ctx context.Context, address, coins, moduleName string) error {
+	return r.run(ctx, runOptions{}, r.chainCmd.AddModuleAccountCommand(address, coins, moduleName))
 }
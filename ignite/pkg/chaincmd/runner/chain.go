@@ -22,7 +22,7 @@ import (
 func (r Runner) Start(ctx context.Context, args ...string) error {
 	return r.run(
 		ctx,
-		runOptions{wrappedStdErrMaxLen: 50000},
+		runOptions{wrappedStdErrMaxLen: longRunningStdErrMaxLen},
 		r.chainCmd.StartCommand(args...),
 	)
 }
@@ -113,6 +113,14 @@ func (r Runner) ShowNodeID(ctx context.Context) (nodeID string, err error) {
 	return
 }
 
+// ShowValidator shows the consensus public key of the node.
+func (r Runner) ShowValidator(ctx context.Context) (pubKey string, err error) {
+	b := newBuffer()
+	err = r.run(ctx, runOptions{stdout: b}, r.chainCmd.ShowValidatorCommand())
+	pubKey = strings.TrimSpace(b.String())
+	return
+}
+
 // NodeStatus keeps info about node's status.
 type NodeStatus struct {
 	ChainID string
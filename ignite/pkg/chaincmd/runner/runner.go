@@ -81,6 +81,9 @@ func (r Runner) Cmd() chaincmd.ChainCmd {
 	return r.chainCmd
 }
 
+// longRunningStdErrMaxLen is the maximum length of the wrapped error logs of the long-running commands.
+const longRunningStdErrMaxLen = 50000
+
 type runOptions struct {
 	// wrappedStdErrMaxLen determines the maximum length of the wrapped error logs
 	// this option is used for long-running command to prevent the buffer containing stderr getting too big
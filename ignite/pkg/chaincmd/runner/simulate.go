@@ -2,11 +2,14 @@ package chaincmdrunner
 
 import (
 	"context"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
 )
 
 // Simulation run the chain simulation.
@@ -18,22 +21,56 @@ func (r Runner) Simulation(
 	genesisTime int64,
 ) error {
 	return r.run(ctx, runOptions{stdout: os.Stdout},
-		chaincmd.SimulationCommand(
-			appPath,
-			simName,
-			chaincmd.SimappWithGenesis(config.GenesisFile),
-			chaincmd.SimappWithParams(config.ParamsFile),
-			chaincmd.SimappWithExportParamsPath(config.ExportParamsPath),
-			chaincmd.SimappWithExportParamsHeight(config.ExportParamsHeight),
-			chaincmd.SimappWithExportStatePath(config.ExportStatePath),
-			chaincmd.SimappWithExportStatsPath(config.ExportStatsPath),
-			chaincmd.SimappWithSeed(config.Seed),
-			chaincmd.SimappWithInitialBlockHeight(uint64(config.InitialBlockHeight)),
-			chaincmd.SimappWithNumBlocks(uint64(config.NumBlocks)),
-			chaincmd.SimappWithBlockSize(config.BlockSize),
-			chaincmd.SimappWithLean(config.Lean),
-			chaincmd.SimappWithCommit(config.Commit),
-			chaincmd.SimappWithEnable(enabled),
-			chaincmd.SimappWithGenesisTime(genesisTime),
-		))
+		simulationCommand(appPath, simName, enabled, config, genesisTime))
+}
+
+// SimulationOutput runs the chain simulation like Simulation and writes its output to w instead of stdout.
+// The error only wraps the beginning of the stderr output, which is fully written to w.
+func (r Runner) SimulationOutput(
+	ctx context.Context,
+	w io.Writer,
+	appPath, simName string,
+	enabled bool,
+	config simulation.Config,
+	genesisTime int64,
+) error {
+	return r.run(ctx, runOptions{stdout: w, stderr: w, wrappedStdErrMaxLen: longRunningStdErrMaxLen},
+		simulationCommand(appPath, simName, enabled, config, genesisTime))
+}
+
+// SimulationCommandLine returns the command line running the chain simulation.
+func SimulationCommandLine(
+	appPath, simName string,
+	enabled bool,
+	config simulation.Config,
+	genesisTime int64,
+) string {
+	s := step.New(simulationCommand(appPath, simName, enabled, config, genesisTime))
+	return strings.Join(append([]string{s.Exec.Command}, s.Exec.Args...), " ")
+}
+
+func simulationCommand(
+	appPath, simName string,
+	enabled bool,
+	config simulation.Config,
+	genesisTime int64,
+) step.Option {
+	return chaincmd.SimulationCommand(
+		appPath,
+		simName,
+		chaincmd.SimappWithGenesis(config.GenesisFile),
+		chaincmd.SimappWithParams(config.ParamsFile),
+		chaincmd.SimappWithExportParamsPath(config.ExportParamsPath),
+		chaincmd.SimappWithExportParamsHeight(config.ExportParamsHeight),
+		chaincmd.SimappWithExportStatePath(config.ExportStatePath),
+		chaincmd.SimappWithExportStatsPath(config.ExportStatsPath),
+		chaincmd.SimappWithSeed(config.Seed),
+		chaincmd.SimappWithInitialBlockHeight(uint64(config.InitialBlockHeight)),
+		chaincmd.SimappWithNumBlocks(uint64(config.NumBlocks)),
+		chaincmd.SimappWithBlockSize(config.BlockSize),
+		chaincmd.SimappWithLean(config.Lean),
+		chaincmd.SimappWithCommit(config.Commit),
+		chaincmd.SimappWithEnable(enabled),
+		chaincmd.SimappWithGenesisTime(genesisTime),
+	)
 }
@@ -59,6 +59,7 @@ type Registry struct {
 	homePath           string
 	keyringServiceName string
 	keyringBackend     KeyringBackend
+	cdc                codec.Codec
 
 	Keyring keyring.Keyring
 }
@@ -100,8 +101,8 @@ func New(options ...Option) (Registry, error) {
 	inBuf := bufio.NewReader(os.Stdin)
 	interfaceRegistry := types.NewInterfaceRegistry()
 	cryptocodec.RegisterInterfaces(interfaceRegistry)
-	cdc := codec.NewProtoCodec(interfaceRegistry)
-	r.Keyring, err = keyring.New(r.keyringServiceName, string(r.keyringBackend), r.homePath, inBuf, cdc)
+	r.cdc = codec.NewProtoCodec(interfaceRegistry)
+	r.Keyring, err = keyring.New(r.keyringServiceName, string(r.keyringBackend), r.homePath, inBuf, r.cdc)
 	if err != nil {
 		return Registry{}, err
 	}
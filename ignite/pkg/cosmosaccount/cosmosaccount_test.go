@@ -1,10 +1,14 @@
 package cosmosaccount_test
 
 import (
+	"encoding/base64"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
 )
 
@@ -70,3 +74,78 @@ func TestRegistry(t *testing.T) {
 	_, err = registry.GetByAddress(addr)
 	require.ErrorAs(t, err, &expectedErr)
 }
+
+func TestRegistryOfflineAccounts(t *testing.T) {
+	registry, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	alice, _, err := registry.Create("alice")
+	require.NoError(t, err)
+	bob, _, err := registry.Create("bob")
+	require.NoError(t, err)
+
+	// import a watch-only account from the JSON encoded public key of another keyring
+	other, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	carol, _, err := other.Create("carol")
+	require.NoError(t, err)
+	carolPubKey, err := carol.Record.GetPubKey()
+	require.NoError(t, err)
+
+	pubKey, err := registry.ParsePubKey(
+		fmt.Sprintf(`{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"%s"}`, base64.StdEncoding.EncodeToString(carolPubKey.Bytes())),
+	)
+	require.NoError(t, err)
+	watched, err := registry.ImportPubKey("carol", pubKey)
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeOffline, watched.Record.GetType())
+	requireSameAddress(t, carol, watched)
+
+	_, err = registry.ImportPubKey("carol", pubKey)
+	require.ErrorIs(t, err, cosmosaccount.ErrAccountExists)
+
+	// create a multisig account
+	_, err = registry.CreateMultisig("multi", 3, []string{"alice", "bob"})
+	require.EqualError(t, err, "threshold must be between 1 and the number of accounts (2), got 3")
+	_, err = registry.CreateMultisig("multi", 1, []string{"alice", "alice"})
+	require.EqualError(t, err, `account "alice" is used more than once`)
+
+	multi, err := registry.CreateMultisig("multi", 2, []string{"alice", "bob", "carol"})
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeMulti, multi.Record.GetType())
+
+	// export the keyring and import it in another keyring
+	armor, exported, err := registry.ExportKeyring("password")
+	require.NoError(t, err)
+	require.Len(t, exported, 4)
+
+	imported, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	_, err = imported.ImportKeyring(armor, "wrong password")
+	require.ErrorIs(t, err, cosmosaccount.ErrInvalidPassphrase)
+
+	accounts, err := imported.ImportKeyring(armor, "password")
+	require.NoError(t, err)
+	require.Len(t, accounts, 4)
+
+	for _, want := range []cosmosaccount.Account{alice, bob, watched, multi} {
+		got, err := imported.GetByName(want.Name)
+		require.NoError(t, err)
+		require.Equal(t, want.Record.GetType(), got.Record.GetType())
+		requireSameAddress(t, want, got)
+	}
+
+	_, err = imported.ImportKeyring(armor, "password")
+	require.ErrorIs(t, err, cosmosaccount.ErrAccountExists)
+}
+
+func requireSameAddress(t *testing.T, want, got cosmosaccount.Account) {
+	t.Helper()
+
+	wantAddr, err := want.Address("")
+	require.NoError(t, err)
+	gotAddr, err := got.Address("")
+	require.NoError(t, err)
+	require.Equal(t, wantAddr, gotAddr)
+}
@@ -0,0 +1,188 @@
+package cosmosaccount
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/xsalsa20symmetric"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	keyringArmorType = "IGNITE KEYRING"
+	keyringArmorKDF  = "bcrypt"
+	keyringSaltSize  = 16
+
+	headerKDF  = "kdf"
+	headerSalt = "salt"
+)
+
+// ErrInvalidPassphrase is returned when a keyring export can't be decrypted with a passphrase.
+var ErrInvalidPassphrase = errors.New("invalid passphrase")
+
+type (
+	keyringExport struct {
+		Accounts []accountExport `json:"accounts"`
+	}
+
+	accountExport struct {
+		Name  string `json:"name"`
+		Type  string `json:"type"`
+		Armor string `json:"armor"`
+	}
+)
+
+// ExportKeyring exports all the accounts of the keyring to an armored string encrypted with the passphrase.
+// The private keys of the local accounts are exported, as well as the public keys of the watch-only and
+// multisig accounts. Ledger accounts are not exported because their keys are stored in the device.
+// The exported accounts are returned with the armored string.
+func (r Registry) ExportKeyring(passphrase string) (string, []Account, error) {
+	accounts, err := r.List()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var (
+		export   keyringExport
+		exported []Account
+	)
+	for _, acc := range accounts {
+		var (
+			armor string
+			err   error
+		)
+		switch acc.Record.GetType() {
+		case keyring.TypeLocal:
+			armor, err = r.Keyring.ExportPrivKeyArmor(acc.Name, passphrase)
+		case keyring.TypeOffline, keyring.TypeMulti:
+			armor, err = r.Keyring.ExportPubKeyArmor(acc.Name)
+		default:
+			continue
+		}
+		if err != nil {
+			return "", nil, errors.Errorf("exporting account %q: %w", acc.Name, err)
+		}
+
+		export.Accounts = append(export.Accounts, accountExport{
+			Name:  acc.Name,
+			Type:  acc.Record.GetType().String(),
+			Armor: armor,
+		})
+		exported = append(exported, acc)
+	}
+
+	data, err := json.Marshal(export)
+	if err != nil {
+		return "", nil, err
+	}
+
+	salt := make([]byte, keyringSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", nil, err
+	}
+	key, err := keyringEncryptionKey(salt, passphrase)
+	if err != nil {
+		return "", nil, err
+	}
+
+	headers := map[string]string{
+		headerKDF:  keyringArmorKDF,
+		headerSalt: hex.EncodeToString(salt),
+	}
+	return crypto.EncodeArmor(keyringArmorType, headers, xsalsa20symmetric.EncryptSymmetric(data, key)), exported, nil
+}
+
+// ImportKeyring imports the accounts of a keyring export decrypted with the passphrase.
+// None of the accounts are imported when an account with the same name already exists.
+func (r Registry) ImportKeyring(armor, passphrase string) ([]Account, error) {
+	blockType, headers, data, err := crypto.DecodeArmor(armor)
+	if err != nil {
+		return nil, errors.Errorf("invalid keyring export: %w", err)
+	}
+	if blockType != keyringArmorType {
+		return nil, errors.Errorf("invalid keyring export type %q", blockType)
+	}
+	if headers[headerKDF] != keyringArmorKDF {
+		return nil, errors.Errorf("unsupported keyring export kdf %q", headers[headerKDF])
+	}
+
+	salt, err := hex.DecodeString(headers[headerSalt])
+	if err != nil {
+		return nil, errors.Errorf("invalid keyring export salt: %w", err)
+	}
+	key, err := keyringEncryptionKey(salt, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	data, err = xsalsa20symmetric.DecryptSymmetric(data, key)
+	if err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+
+	var export keyringExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, errors.Errorf("invalid keyring export: %w", err)
+	}
+
+	for _, a := range export.Accounts {
+		if err := r.checkAccountNotExists(a.Name); err != nil {
+			return nil, errors.Errorf("account %q: %w", a.Name, err)
+		}
+	}
+
+	accounts := make([]Account, 0, len(export.Accounts))
+	for _, a := range export.Accounts {
+		if err := r.importAccount(a, passphrase); err != nil {
+			return nil, errors.Errorf("importing account %q: %w", a.Name, err)
+		}
+
+		acc, err := r.GetByName(a.Name)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, acc)
+	}
+	return accounts, nil
+}
+
+func (r Registry) importAccount(a accountExport, passphrase string) error {
+	switch a.Type {
+	case keyring.TypeLocal.String():
+		return r.Keyring.ImportPrivKey(a.Name, a.Armor, passphrase)
+	case keyring.TypeOffline.String():
+		return r.Keyring.ImportPubKey(a.Name, a.Armor)
+	case keyring.TypeMulti.String():
+		bz, _, err := crypto.UnarmorPubKeyBytes(a.Armor)
+		if err != nil {
+			return err
+		}
+
+		var pk cryptotypes.PubKey
+		if err := r.cdc.UnmarshalInterface(bz, &pk); err != nil {
+			return err
+		}
+
+		_, err = r.Keyring.SaveMultisig(a.Name, pk)
+		return err
+	default:
+		return errors.Errorf("unsupported account type %q", a.Type)
+	}
+}
+
+// keyringEncryptionKey derives the key used to encrypt a keyring export from a passphrase.
+func keyringEncryptionKey(salt []byte, passphrase string) ([]byte, error) {
+	key, err := bcrypt.GenerateFromPassword(salt, []byte(passphrase), crypto.BcryptSecurityParameter)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(key)
+	return hash[:], nil
+}
@@ -0,0 +1,105 @@
+package cosmosaccount
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// ParsePubKey parses a JSON encoded public key, like the ones displayed by the
+// "keys show --pubkey" command of the chains, e.g.:
+// {"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8Ks..."}.
+func (r Registry) ParsePubKey(pubKey string) (cryptotypes.PubKey, error) {
+	var pk cryptotypes.PubKey
+	if err := r.cdc.UnmarshalInterfaceJSON([]byte(pubKey), &pk); err != nil {
+		return nil, errors.Errorf("invalid public key %s: %w", pubKey, err)
+	}
+	return pk, nil
+}
+
+// ImportPubKey imports a watch-only account from its public key.
+// A watch-only account doesn't have a private key and can't sign transactions.
+func (r Registry) ImportPubKey(name string, pubKey cryptotypes.PubKey) (Account, error) {
+	if err := r.checkAccountNotExists(name); err != nil {
+		return Account{}, err
+	}
+
+	record, err := r.Keyring.SaveOfflineKey(name, pubKey)
+	if err != nil {
+		return Account{}, err
+	}
+
+	return Account{
+		Name:   name,
+		Record: record,
+	}, nil
+}
+
+// CreateMultisig creates a multisig account from the public keys of existing accounts.
+// The transactions of the account must be signed by a number of accounts equal to the threshold.
+// Like for the chains, the public keys are sorted by address.
+func (r Registry) CreateMultisig(name string, threshold int, accountNames []string) (Account, error) {
+	if err := r.checkAccountNotExists(name); err != nil {
+		return Account{}, err
+	}
+
+	if threshold <= 0 || threshold > len(accountNames) {
+		return Account{}, errors.Errorf(
+			"threshold must be between 1 and the number of accounts (%d), got %d",
+			len(accountNames),
+			threshold,
+		)
+	}
+
+	var (
+		pubKeys = make([]cryptotypes.PubKey, 0, len(accountNames))
+		names   = make(map[string]bool)
+	)
+	for _, n := range accountNames {
+		if names[n] {
+			return Account{}, errors.Errorf("account %q is used more than once", n)
+		}
+		names[n] = true
+
+		acc, err := r.GetByName(n)
+		if err != nil {
+			return Account{}, err
+		}
+		pk, err := acc.Record.GetPubKey()
+		if err != nil {
+			return Account{}, err
+		}
+		pubKeys = append(pubKeys, pk)
+	}
+
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i].Address(), pubKeys[j].Address()) < 0
+	})
+
+	record, err := r.Keyring.SaveMultisig(name, multisig.NewLegacyAminoPubKey(threshold, pubKeys))
+	if err != nil {
+		return Account{}, err
+	}
+
+	return Account{
+		Name:   name,
+		Record: record,
+	}, nil
+}
+
+func (r Registry) checkAccountNotExists(name string) error {
+	_, err := r.GetByName(name)
+	if err == nil {
+		return ErrAccountExists
+	}
+
+	var accErr *AccountDoesNotExistError
+	if errors.As(err, &accErr) {
+		return nil
+	}
+	return err
+}
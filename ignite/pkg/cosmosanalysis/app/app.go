@@ -5,8 +5,12 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosanalysis"
@@ -19,6 +23,9 @@ import (
 
 const registerRoutesMethod = "RegisterAPIRoutes"
 
+// ErrNoIBC is returned when the app doesn't wire the IBC modules.
+var ErrNoIBC = errors.New("the app doesn't wire the IBC modules")
+
 // CheckKeeper checks for the existence of the keeper with the provided name in the app structure.
 func CheckKeeper(path, keeperName string) error {
 	// find app type
@@ -103,8 +110,11 @@ func FindRegisteredModules(chainRoot string) ([]string, error) {
 
 	// Discover IBC wired modules
 	// TODO: This can be removed once IBC modules use dependency injection
-	ibcPath := filepath.Join(chainRoot, "app", "ibc.go")
-	if _, err := os.Stat(ibcPath); err == nil {
+	ibcPath, err := FindIBCFilePath(chainRoot)
+	if err != nil && !errors.Is(err, ErrNoIBC) {
+		return nil, err
+	}
+	if err == nil {
 		m, err := discoverIBCModules(ibcPath)
 		if err != nil {
 			return nil, err
@@ -259,7 +269,7 @@ func discoverIBCModules(ibcPath string) ([]string, error) {
 			return nil
 		}
 
-		if fn.Name.Name != "RegisterIBC" && fn.Name.Name != "AddIBCModuleManager" {
+		if !isIBCRegisterFunc(fn) {
 			return nil
 		}
 
@@ -301,6 +311,130 @@ func discoverIBCModules(ibcPath string) ([]string, error) {
 	return modules, nil
 }
 
+// IBCRoute is a route of the IBC router wired by the app.
+type IBCRoute struct {
+	// Port is the port of the route, e.g. transfer.
+	Port string
+
+	// PortExpr is the expression of the port in the IBC file, e.g. ibctransfertypes.ModuleName.
+	PortExpr string
+
+	// Module is the expression of the IBC module stack of the route, e.g. transferIBCModule.
+	Module string
+}
+
+// FindIBCFilePath returns the path of the app file that wires the IBC modules,
+// as they don't support dependency injection. ErrNoIBC is returned when the
+// app doesn't wire IBC, e.g. minimal chains.
+func FindIBCFilePath(chainRoot string) (string, error) {
+	appFilePath, err := cosmosanalysis.FindAppFilePath(chainRoot)
+	if err != nil {
+		return "", err
+	}
+
+	appDir := filepath.Dir(appFilePath)
+	entries, err := os.ReadDir(appDir)
+	if err != nil {
+		return "", err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		filePath := filepath.Join(appDir, name)
+		f, _, err := xast.ParseFile(filePath)
+		if err != nil {
+			return "", err
+		}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && isIBCRegisterFunc(fn) {
+				return filePath, nil
+			}
+		}
+	}
+	return "", ErrNoIBC
+}
+
+// DiscoverIBCRoutes returns the routes added to the IBC router in the IBC file of the app.
+func DiscoverIBCRoutes(ibcPath string) ([]IBCRoute, error) {
+	f, _, err := xast.ParseFile(ibcPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		routes    []IBCRoute
+		positions = make(map[string]token.Pos)
+		imports   = goanalysis.FormatImports(f)
+	)
+	err = xast.Inspect(f, func(n ast.Node) error {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return nil
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "AddRoute" {
+			return nil
+		}
+
+		route := IBCRoute{
+			Port:     ibcRoutePort(call.Args[0], imports),
+			PortExpr: types.ExprString(call.Args[0]),
+			Module:   types.ExprString(call.Args[1]),
+		}
+		positions[route.PortExpr] = call.Args[0].Pos()
+		routes = append(routes, route)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The routes of a chained call are inspected from the last one,
+	// so they are sorted by their position in the file.
+	sort.SliceStable(routes, func(i, j int) bool {
+		return positions[routes[i].PortExpr] < positions[routes[j].PortExpr]
+	})
+	return routes, nil
+}
+
+// ibcRoutePort returns the port of the IBC route from the port expression.
+// The port of a module constant is named after the package of the constant,
+// e.g. ibctransfertypes.ModuleName is transfer and icahosttypes.SubModuleName is icahost.
+func ibcRoutePort(expr ast.Expr, imports map[string]string) string {
+	switch x := expr.(type) {
+	case *ast.BasicLit:
+		if port, err := strconv.Unquote(x.Value); err == nil {
+			return port
+		}
+	case *ast.SelectorExpr:
+		ident, ok := x.X.(*ast.Ident)
+		if !ok {
+			break
+		}
+		pkgPath, ok := imports[ident.Name]
+		if !ok {
+			break
+		}
+		pkgPath = strings.TrimSuffix(pkgPath, "/types")
+		port := path.Base(pkgPath)
+		if strings.Contains(pkgPath, "27-interchain-accounts/") {
+			port = "ica" + port
+		}
+		return port
+	}
+	return types.ExprString(expr)
+}
+
+// isIBCRegisterFunc checks if the function registers the IBC modules on the client side.
+func isIBCRegisterFunc(fn *ast.FuncDecl) bool {
+	return fn.Name.Name == "RegisterIBC" || fn.Name.Name == "AddIBCModuleManager"
+}
+
 func resolveCosmosPackagePath(chainRoot string) (string, error) {
 	modFile, err := gomodule.ParseAt(chainRoot)
 	if err != nil {
@@ -197,6 +197,33 @@ func TestDiscoverModules(t *testing.T) {
 	}
 }
 
+func TestDiscoverIBCRoutes(t *testing.T) {
+	got, err := DiscoverIBCRoutes("testdata/ibc/ibc.go")
+	require.NoError(t, err)
+	require.Equal(t, []IBCRoute{
+		{
+			Port:     "transfer",
+			PortExpr: "ibctransfertypes.ModuleName",
+			Module:   "transferIBCModule",
+		},
+		{
+			Port:     "icacontroller",
+			PortExpr: "icacontrollertypes.SubModuleName",
+			Module:   "icaControllerIBCModule",
+		},
+		{
+			Port:     "icahost",
+			PortExpr: "icahosttypes.SubModuleName",
+			Module:   "icaHostIBCModule",
+		},
+		{
+			Port:     "mars",
+			PortExpr: "marsmoduletypes.ModuleName",
+			Module:   "marsIBCModule",
+		},
+	}, got)
+}
+
 func Test_removeKeeperPkgPath(t *testing.T) {
 	tests := []struct {
 		name string
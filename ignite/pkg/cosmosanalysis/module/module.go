@@ -61,6 +61,9 @@ type HTTPQuery struct {
 	// FullName of the query with service name and rpc func name.
 	FullName string `json:"full_name,omitempty"`
 
+	// ResponseType is the name of the response message of the query.
+	ResponseType string `json:"response_type,omitempty"`
+
 	// Rules keeps info about configured HTTP rules of RPC functions.
 	Rules []protoanalysis.HTTPRule `json:"rules,omitempty"`
 
@@ -330,10 +333,11 @@ func (d *moduleDiscoverer) discover(pkg protoanalysis.Package) (Module, error) {
 			}
 
 			m.HTTPQueries = append(m.HTTPQueries, HTTPQuery{
-				Name:      q.Name,
-				FullName:  s.Name + q.Name,
-				Rules:     q.HTTPRules,
-				Paginated: q.Paginated,
+				Name:         q.Name,
+				FullName:     s.Name + q.Name,
+				ResponseType: q.ReturnsType,
+				Rules:        q.HTTPRules,
+				Paginated:    q.Paginated,
 			})
 		}
 	}
@@ -67,6 +67,8 @@ func newModule(relChainPath, goImportPath string) module.Module {
 							ReturnsType: "QueryMyQueryResponse",
 							HTTPRules: []protoanalysis.HTTPRule{
 								{
+									Method:   "GET",
+									Endpoint: "/tendermint/mars/withoutmsg/my_query/{mytypefield}",
 									Params:   []string{"mytypefield"},
 									HasQuery: true,
 									HasBody:  false,
@@ -80,6 +82,8 @@ func newModule(relChainPath, goImportPath string) module.Module {
 							ReturnsType: "QueryFooResponse",
 							HTTPRules: []protoanalysis.HTTPRule{
 								{
+									Method:   "GET",
+									Endpoint: "/tendermint/mars/withoutmsg/foo/",
 									HasQuery: false,
 									HasBody:  false,
 								},
@@ -92,10 +96,13 @@ func newModule(relChainPath, goImportPath string) module.Module {
 		Msgs: []module.Msg(nil),
 		HTTPQueries: []module.HTTPQuery{
 			{
-				Name:     "MyQuery",
-				FullName: "QueryMyQuery",
+				Name:         "MyQuery",
+				FullName:     "QueryMyQuery",
+				ResponseType: "QueryMyQueryResponse",
 				Rules: []protoanalysis.HTTPRule{
 					{
+						Method:   "GET",
+						Endpoint: "/tendermint/mars/withoutmsg/my_query/{mytypefield}",
 						Params:   []string{"mytypefield"},
 						HasQuery: true,
 						HasBody:  false,
@@ -104,10 +111,13 @@ func newModule(relChainPath, goImportPath string) module.Module {
 				Paginated: true,
 			},
 			{
-				Name:     "Foo",
-				FullName: "QueryFoo",
+				Name:         "Foo",
+				FullName:     "QueryFoo",
+				ResponseType: "QueryFooResponse",
 				Rules: []protoanalysis.HTTPRule{
 					{
+						Method:   "GET",
+						Endpoint: "/tendermint/mars/withoutmsg/foo/",
 						HasQuery: false,
 						HasBody:  false,
 					},
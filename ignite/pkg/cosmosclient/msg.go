@@ -0,0 +1,104 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// ProtoFiles returns the proto files registered by the chain.
+// The files are fetched from the reflection service of the node.
+func (c Client) ProtoFiles(ctx context.Context) (*protoregistry.Files, error) {
+	res, err := reflectionv1.NewReflectionServiceClient(c.context).
+		FileDescriptors(ctx, &reflectionv1.FileDescriptorsRequest{})
+	if err != nil {
+		return nil, errors.Errorf("fetching the chain proto files: %w", err)
+	}
+
+	files, err := protodesc.FileOptions{AllowUnresolvable: true}.
+		NewFiles(&descriptorpb.FileDescriptorSet{File: res.Files})
+	if err != nil {
+		return nil, errors.Errorf("invalid chain proto files: %w", err)
+	}
+	return files, nil
+}
+
+// NewMsgFromJSON returns a message of any type registered by the chain from
+// its type URL and its JSON body.
+// The message is built using the proto files of the chain so its type doesn't
+// need to be registered in the client to broadcast it.
+func NewMsgFromJSON(files *protoregistry.Files, typeURL string, body []byte) (sdktypes.Msg, error) {
+	msg, err := newDynamicMsg(files, typeURL)
+	if err != nil {
+		return nil, err
+	}
+	opts := protojson.UnmarshalOptions{Resolver: dynamicpb.NewTypes(files)}
+	if err := opts.Unmarshal(body, msg); err != nil {
+		return nil, errors.Errorf("invalid %s message: %w", typeURL, err)
+	}
+	return msg, nil
+}
+
+// MsgJSON returns the JSON of a message of any type registered by the chain
+// from its type URL and its proto encoded value.
+func MsgJSON(files *protoregistry.Files, typeURL string, value []byte) ([]byte, error) {
+	msg, err := newDynamicMsg(files, typeURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return nil, errors.Errorf("invalid %s message: %w", typeURL, err)
+	}
+
+	opts := protojson.MarshalOptions{Resolver: dynamicpb.NewTypes(files)}
+	return opts.Marshal(msg)
+}
+
+// MsgResponseJSON returns the JSON of the response of the message at index in
+// the transaction, the response type is resolved from the proto files of the chain.
+func (r Response) MsgResponseJSON(files *protoregistry.Files, index int) ([]byte, error) {
+	data, err := hex.DecodeString(r.Data)
+	if err != nil {
+		return nil, errors.Errorf("invalid transaction data: %w", err)
+	}
+
+	var txMsgData sdktypes.TxMsgData
+	if err := txMsgData.Unmarshal(data); err != nil {
+		return nil, errors.Errorf("invalid transaction data: %w", err)
+	}
+	if index < 0 || index >= len(txMsgData.MsgResponses) {
+		return nil, errors.Errorf("no response for the message %d of the transaction", index)
+	}
+
+	res := txMsgData.MsgResponses[index]
+	return MsgJSON(files, res.TypeUrl, res.Value)
+}
+
+// newDynamicMsg returns an empty message of a type registered by the chain.
+func newDynamicMsg(files *protoregistry.Files, typeURL string) (*dynamicpb.Message, error) {
+	name := protoreflect.FullName(strings.TrimPrefix(typeURL, "/"))
+	d, err := files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, errors.Errorf("message type %s not found in the chain proto files", typeURL)
+	}
+
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, errors.Errorf("%s is not a message type", typeURL)
+	}
+	return dynamicpb.NewMessage(md), nil
+}
@@ -0,0 +1,107 @@
+package cosmosclient_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	_ "cosmossdk.io/api/cosmos/bank/v1beta1"
+	_ "cosmossdk.io/api/cosmos/gov/v1"
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+)
+
+func TestNewMsgFromJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		typeURL string
+		body    string
+		want    sdk.Msg
+		err     string
+	}{
+		{
+			name:    "valid message",
+			typeURL: "/cosmos.bank.v1beta1.MsgSend",
+			body:    `{"from_address":"alice","to_address":"bob","amount":[{"denom":"token","amount":"10"}]}`,
+			want: &banktypes.MsgSend{
+				FromAddress: "alice",
+				ToAddress:   "bob",
+				Amount:      sdk.NewCoins(sdk.NewCoin("token", math.NewInt(10))),
+			},
+		},
+		{
+			name:    "unknown message type",
+			typeURL: "/cosmos.bank.v1beta1.MsgUnknown",
+			body:    `{}`,
+			err:     "message type /cosmos.bank.v1beta1.MsgUnknown not found in the chain proto files",
+		},
+		{
+			name:    "invalid body",
+			typeURL: "/cosmos.bank.v1beta1.MsgSend",
+			body:    `{"sender":"alice"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := cosmosclient.NewMsgFromJSON(protoregistry.GlobalFiles, tt.typeURL, []byte(tt.body))
+			if tt.want == nil {
+				require.Error(t, err)
+				if tt.err != "" {
+					require.EqualError(t, err, tt.err)
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			// The message must be packed as the message type registered by the chain
+			got, err := codectypes.NewAnyWithValue(msg)
+			require.NoError(t, err)
+			want, err := codectypes.NewAnyWithValue(tt.want)
+			require.NoError(t, err)
+			require.Equal(t, want.TypeUrl, got.TypeUrl)
+			require.Equal(t, want.Value, got.Value)
+		})
+	}
+}
+
+func TestMsgJSON(t *testing.T) {
+	msg := &banktypes.MsgSend{
+		FromAddress: "alice",
+		ToAddress:   "bob",
+		Amount:      sdk.NewCoins(sdk.NewCoin("token", math.NewInt(10))),
+	}
+	value, err := msg.Marshal()
+	require.NoError(t, err)
+
+	got, err := cosmosclient.MsgJSON(protoregistry.GlobalFiles, "/cosmos.bank.v1beta1.MsgSend", value)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"fromAddress":"alice","toAddress":"bob","amount":[{"denom":"token","amount":"10"}]}`, string(got))
+
+	_, err = cosmosclient.MsgJSON(protoregistry.GlobalFiles, "/cosmos.bank.v1beta1.MsgUnknown", value)
+	require.EqualError(t, err, "message type /cosmos.bank.v1beta1.MsgUnknown not found in the chain proto files")
+}
+
+func TestResponseMsgResponseJSON(t *testing.T) {
+	res, err := codectypes.NewAnyWithValue(&govv1.MsgSubmitProposalResponse{ProposalId: 42})
+	require.NoError(t, err)
+	txMsgData := sdk.TxMsgData{MsgResponses: []*codectypes.Any{res}}
+	data, err := txMsgData.Marshal()
+	require.NoError(t, err)
+
+	r := cosmosclient.Response{TxResponse: &sdk.TxResponse{Data: hex.EncodeToString(data)}}
+
+	got, err := r.MsgResponseJSON(protoregistry.GlobalFiles, 0)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"proposalId":"42"}`, string(got))
+
+	_, err = r.MsgResponseJSON(protoregistry.GlobalFiles, 1)
+	require.EqualError(t, err, "no response for the message 1 of the transaction")
+}
@@ -0,0 +1,124 @@
+package cosmosclient
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// StoreSize is the size of a module store.
+type StoreSize struct {
+	// Keys is the number of keys of the store.
+	Keys int
+
+	// Bytes is the size of the keys and values of the store.
+	Bytes int
+}
+
+// StoreKeys returns the names of the module stores of the app.
+// The names are read from the app config, so the stores of the modules
+// that are not wired with the app config are not included.
+func (c Client) StoreKeys(ctx context.Context) ([]string, error) {
+	res, err := appv1alpha1.NewQueryClient(c.context).Config(ctx, &appv1alpha1.QueryConfigRequest{})
+	if err != nil {
+		return nil, errors.Errorf("fetching the app config: %w", err)
+	}
+
+	// Stores are named after their module unless overridden in the runtime config
+	overrides := make(map[string]string)
+	for _, m := range res.Config.GetModules() {
+		var runtime runtimev1alpha1.Module
+		if m.Config == nil || m.Config.UnmarshalTo(&runtime) != nil {
+			continue
+		}
+		for _, o := range runtime.OverrideStoreKeys {
+			overrides[o.ModuleName] = o.KvStoreKey
+		}
+	}
+
+	var keys []string
+	for _, m := range res.Config.GetModules() {
+		key := m.Name
+		if o, ok := overrides[key]; ok {
+			key = o
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// StoreSize returns the size of a module store at the latest height.
+// All the entries of the store are fetched so it should only be used with development chains.
+func (c Client) StoreSize(ctx context.Context, storeKey string) (StoreSize, error) {
+	res, err := c.RPC.ABCIQuery(ctx, fmt.Sprintf("/store/%s/subspace", storeKey), nil)
+	if err != nil {
+		return StoreSize{}, err
+	}
+	if !res.Response.IsOK() {
+		return StoreSize{}, errors.Errorf("querying store %s: %s", storeKey, res.Response.Log)
+	}
+
+	// The response is a list of key/value pairs, each pair being encoded
+	// as the first field of the list and holding the key and the value
+	var size StoreSize
+	b := res.Response.Value
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return StoreSize{}, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if num != 1 || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return StoreSize{}, protowire.ParseError(n)
+			}
+			b = b[n:]
+			continue
+		}
+
+		pair, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return StoreSize{}, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		pairBytes, err := kvPairBytes(pair)
+		if err != nil {
+			return StoreSize{}, err
+		}
+		size.Keys++
+		size.Bytes += pairBytes
+	}
+	return size, nil
+}
+
+// kvPairBytes returns the size of the key and the value of an encoded key/value pair.
+func kvPairBytes(pair []byte) (int, error) {
+	var size int
+	for len(pair) > 0 {
+		num, typ, n := protowire.ConsumeTag(pair)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		pair = pair[n:]
+
+		n = protowire.ConsumeFieldValue(num, typ, pair)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		if typ == protowire.BytesType {
+			v, _ := protowire.ConsumeBytes(pair)
+			size += len(v)
+		}
+		pair = pair[n:]
+	}
+	return size, nil
+}
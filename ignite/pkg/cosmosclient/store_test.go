@@ -0,0 +1,52 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient/testutil"
+)
+
+func TestStoreSize(t *testing.T) {
+	var (
+		m   = testutil.NewTendermintClientMock(t)
+		ctx = context.Background()
+	)
+
+	// Encode the key/value pairs as returned by the store subspace query
+	var value []byte
+	for _, pair := range [][2]string{{"key1", "value"}, {"key2", "longer value"}} {
+		var b []byte
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendBytes(b, []byte(pair[0]))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendBytes(b, []byte(pair[1]))
+
+		value = protowire.AppendTag(value, 1, protowire.BytesType)
+		value = protowire.AppendBytes(value, b)
+	}
+
+	m.On("ABCIQuery", ctx, "/store/bank/subspace", bytes.HexBytes(nil)).Return(&ctypes.ResultABCIQuery{
+		Response: abci.ResponseQuery{Value: value},
+	}, nil)
+	m.On("ABCIQuery", ctx, "/store/unknown/subspace", bytes.HexBytes(nil)).Return(&ctypes.ResultABCIQuery{
+		Response: abci.ResponseQuery{Code: 1, Log: "no such store: unknown"},
+	}, nil)
+
+	client := cosmosclient.Client{RPC: m}
+
+	size, err := client.StoreSize(ctx, "bank")
+	require.NoError(t, err)
+	require.Equal(t, cosmosclient.StoreSize{Keys: 2, Bytes: 25}, size)
+
+	_, err = client.StoreSize(ctx, "unknown")
+	require.EqualError(t, err, "querying store unknown: no such store: unknown")
+}
@@ -32,7 +32,8 @@ type generateOptions struct {
 	hooksOut      func(module.Module) string
 	hooksRootPath string
 
-	specOut string
+	specOut   string
+	specV3Out string
 }
 
 // ModulePathFunc defines a function type that returns a path based on a Cosmos SDK module.
@@ -79,6 +80,14 @@ func WithOpenAPIGeneration(out string) Option {
 	}
 }
 
+// WithOpenAPIV3Generation adds the generation of an OpenAPI 3.1 spec converted from the Swagger 2.0 spec.
+// The OpenAPI generation must be enabled with WithOpenAPIGeneration.
+func WithOpenAPIV3Generation(out string) Option {
+	return func(o *generateOptions) {
+		o.specV3Out = out
+	}
+}
+
 // UpdateBufModule enables Buf config proto dependencies update.
 // This option updates app's Buf config when proto packages or
 // Buf modules are found within the Go dependencies.
@@ -145,13 +145,22 @@ func (g *generator) generateOpenAPISpec(ctx context.Context) error {
 		}
 	}
 
-	out := g.opts.specOut
+	// The specs of the modules are cached by checksum for all the outputs,
+	// which are only combined again when a spec or an output changed.
+	outputs := []string{g.opts.specOut}
+	if g.opts.specV3Out != "" {
+		outputs = append(outputs, g.opts.specV3Out)
+	}
 
 	if !hasAnySpecChanged {
-		// In case the generated output has been changed
-		changed, err := dirchange.HasDirChecksumChanged(specCache, out, g.appPath, out)
-		if err != nil {
-			return err
+		var changed bool
+		for _, out := range outputs {
+			// In case the generated output has been changed
+			outChanged, err := dirchange.HasDirChecksumChanged(specCache, out, g.appPath, out)
+			if err != nil {
+				return err
+			}
+			changed = changed || outChanged
 		}
 
 		if !changed {
@@ -160,11 +169,22 @@ func (g *generator) generateOpenAPISpec(ctx context.Context) error {
 	}
 
 	// combine specs into one and save to out.
-	if err := conf.Combine(out); err != nil {
+	if err := conf.Combine(g.opts.specOut); err != nil {
 		return err
 	}
 
-	return dirchange.SaveDirChecksum(specCache, out, g.appPath, out)
+	if g.opts.specV3Out != "" {
+		if err := conf.CombineOpenAPI3(g.opts.specV3Out); err != nil {
+			return err
+		}
+	}
+
+	for _, out := range outputs {
+		if err := dirchange.SaveDirChecksum(specCache, out, g.appPath, out); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func extractRootModulePath(fullPath string) string {
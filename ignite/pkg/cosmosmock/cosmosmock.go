@@ -0,0 +1,220 @@
+// Package cosmosmock serves mocked responses for the HTTP queries of Cosmos SDK modules,
+// to develop the frontend of a chain without building or starting the chain.
+package cosmosmock
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xhttp"
+)
+
+// FixtureExt is the extension of the fixture files.
+const FixtureExt = ".json"
+
+// grpc-gateway status codes of the error responses.
+const (
+	codeInternal = 13
+	codeNotFound = 5
+)
+
+// Route is a mocked HTTP query of a module.
+type Route struct {
+	// Module is the name of the module of the query.
+	Module string
+
+	// Query is the name of the query, e.g. AllBalances.
+	Query string
+
+	// Method is the HTTP method of the query, e.g. GET.
+	Method string
+
+	// Endpoint is the URL template of the query, e.g. /cosmos/bank/v1beta1/balances/{address}.
+	Endpoint string
+
+	// Example is the example response of the query created from its response message.
+	Example any
+}
+
+// Server serves the example responses of the HTTP queries of modules.
+type Server struct {
+	routes      []Route
+	fixturesDir string
+}
+
+// Option configures the server.
+type Option func(*Server)
+
+// WithFixtures serves the fixture files of a directory in place of the example responses.
+// The fixture of a query is the JSON file named after the query in the directory of its
+// module, e.g. bank/AllBalances.json. Fixtures are read on each request so they can be
+// edited while the server is running.
+func WithFixtures(dir string) Option {
+	return func(s *Server) {
+		s.fixturesDir = dir
+	}
+}
+
+// New creates a server for the HTTP queries of modules.
+func New(modules []module.Module, options ...Option) *Server {
+	s := &Server{}
+	for _, apply := range options {
+		apply(s)
+	}
+
+	done := make(map[string]bool)
+	for _, m := range modules {
+		for _, q := range m.HTTPQueries {
+			for _, rule := range q.Rules {
+				if rule.Endpoint == "" {
+					continue
+				}
+
+				method := rule.Method
+				if method == "" {
+					method = http.MethodGet
+				}
+
+				// The modules of the dependencies can be discovered more than once
+				key := method + " " + rule.Endpoint
+				if done[key] {
+					continue
+				}
+				done[key] = true
+
+				s.routes = append(s.routes, Route{
+					Module:   m.Name,
+					Query:    q.Name,
+					Method:   method,
+					Endpoint: rule.Endpoint,
+					Example:  exampleMessage(m.Pkg, q.ResponseType),
+				})
+			}
+		}
+	}
+
+	// The routes with fewer parameters are matched first so that the literal
+	// segments of a route take precedence over the parameters of another one.
+	sort.SliceStable(s.routes, func(i, j int) bool {
+		return strings.Count(s.routes[i].Endpoint, "{") < strings.Count(s.routes[j].Endpoint, "{")
+	})
+
+	return s
+}
+
+// Routes returns the mocked routes sorted by endpoint.
+func (s Server) Routes() []Route {
+	routes := append([]Route{}, s.routes...)
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Endpoint == routes[j].Endpoint {
+			return routes[i].Method < routes[j].Method
+		}
+		return routes[i].Endpoint < routes[j].Endpoint
+	})
+	return routes
+}
+
+// FixturePath returns the path of the fixture file of a route in a fixtures directory.
+func FixturePath(dir string, r Route) string {
+	return filepath.Join(dir, r.Module, r.Query+FixtureExt)
+}
+
+// ServeHTTP serves the fixture or the example response of the route matching the request.
+// Like the API of the chains served by Ignite, cross-origin requests are allowed.
+func (s Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE")
+		w.Header().Set("Access-Control-Allow-Headers", "*")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	route, ok := s.match(r.Method, r.URL.Path)
+	if !ok {
+		_ = xhttp.ResponseJSON(w, http.StatusNotFound, errorResponse(codeNotFound, http.StatusText(http.StatusNotFound)))
+		return
+	}
+
+	response, err := s.response(route)
+	if err != nil {
+		_ = xhttp.ResponseJSON(w, http.StatusInternalServerError, errorResponse(codeInternal, err.Error()))
+		return
+	}
+	_ = xhttp.ResponseJSON(w, http.StatusOK, response)
+}
+
+// response returns the fixture of a route when there is one or its example response.
+func (s Server) response(r Route) (any, error) {
+	if s.fixturesDir == "" {
+		return r.Example, nil
+	}
+
+	path := FixturePath(s.fixturesDir, r)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return r.Example, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var fixture json.RawMessage
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, errors.Errorf("invalid fixture %s: %w", path, err)
+	}
+	return fixture, nil
+}
+
+// match returns the route matching the method and the path of a request.
+func (s Server) match(method, path string) (Route, bool) {
+	for _, r := range s.routes {
+		if r.Method == method && matchEndpoint(r.Endpoint, path) {
+			return r, true
+		}
+	}
+	return Route{}, false
+}
+
+// matchEndpoint checks if a path matches the URL template of an endpoint.
+// A parameter matches a path segment and a parameter with
+// the "**" pattern, like {denom=**}, matches the remaining ones.
+func matchEndpoint(endpoint, path string) bool {
+	var (
+		endpointSegments = strings.Split(strings.Trim(endpoint, "/"), "/")
+		pathSegments     = strings.Split(strings.Trim(path, "/"), "/")
+	)
+	for i, segment := range endpointSegments {
+		if i >= len(pathSegments) {
+			return false
+		}
+
+		isParam := strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+		switch {
+		case isParam && strings.HasSuffix(segment, "=**}"):
+			return true
+		case isParam:
+			if pathSegments[i] == "" {
+				return false
+			}
+		case segment != pathSegments[i]:
+			return false
+		}
+	}
+	return len(endpointSegments) == len(pathSegments)
+}
+
+// errorResponse returns an error response formatted like the ones of the chain API.
+func errorResponse(code int, message string) map[string]any {
+	return map[string]any{
+		"code":    code,
+		"message": message,
+		"details": []any{},
+	}
+}
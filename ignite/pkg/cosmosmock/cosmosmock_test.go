@@ -0,0 +1,141 @@
+package cosmosmock_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosmock"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis"
+)
+
+var blogModule = module.Module{
+	Name: "blog",
+	Pkg: protoanalysis.Package{
+		Name: "blog.blog.v1",
+		Messages: []protoanalysis.Message{
+			{
+				Name:   "Post",
+				Fields: map[string]string{"id": "uint64", "title": "string", "published": "bool", "meta": "Post.Meta"},
+			},
+			{
+				Name:   "Post_Meta",
+				Fields: map[string]string{"likes": "int32"},
+			},
+			{
+				Name:   "QueryGetPostResponse",
+				Fields: map[string]string{"post": "blog.blog.v1.Post"},
+			},
+			{
+				Name:   "QueryAllPostResponse",
+				Fields: map[string]string{"post": "Post", "pagination": "cosmos.base.query.v1beta1.PageResponse"},
+			},
+		},
+	},
+	HTTPQueries: []module.HTTPQuery{
+		{
+			Name:         "GetPost",
+			ResponseType: "QueryGetPostResponse",
+			Rules:        []protoanalysis.HTTPRule{{Method: "GET", Endpoint: "/blog/blog/v1/post/{id}"}},
+		},
+		{
+			Name:         "AllPost",
+			ResponseType: "QueryAllPostResponse",
+			Rules:        []protoanalysis.HTTPRule{{Method: "GET", Endpoint: "/blog/blog/v1/post"}},
+		},
+		{
+			Name:         "PostBySlug",
+			ResponseType: "QueryGetPostResponse",
+			Rules:        []protoanalysis.HTTPRule{{Method: "GET", Endpoint: "/blog/blog/v1/post/slug/{slug=**}"}},
+		},
+	},
+}
+
+func TestServer(t *testing.T) {
+	fixtures := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(fixtures, "blog"), 0o755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(fixtures, "blog", "PostBySlug.json"),
+		[]byte(`{"post":{"id":"7","title":"Hello"}}`),
+		0o600,
+	))
+
+	s := cosmosmock.New([]module.Module{blogModule}, cosmosmock.WithFixtures(fixtures))
+	require.Len(t, s.Routes(), 3)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+		want       string
+	}{
+		{
+			name:       "example response",
+			method:     http.MethodGet,
+			path:       "/blog/blog/v1/post/1",
+			wantStatus: http.StatusOK,
+			want:       `{"post":{"id":"0","title":"title","published":false,"meta":{"likes":0}}}`,
+		},
+		{
+			name:       "paginated example response",
+			method:     http.MethodGet,
+			path:       "/blog/blog/v1/post",
+			wantStatus: http.StatusOK,
+			want:       `{"post":[{"id":"0","title":"title","published":false,"meta":{"likes":0}}],"pagination":{"next_key":null,"total":"1"}}`,
+		},
+		{
+			name:       "fixture response",
+			method:     http.MethodGet,
+			path:       "/blog/blog/v1/post/slug/2024/hello",
+			wantStatus: http.StatusOK,
+			want:       `{"post":{"id":"7","title":"Hello"}}`,
+		},
+		{
+			name:       "unknown route",
+			method:     http.MethodGet,
+			path:       "/blog/blog/v1/comment",
+			wantStatus: http.StatusNotFound,
+			want:       `{"code":5,"message":"Not Found","details":[]}`,
+		},
+		{
+			name:       "unknown method",
+			method:     http.MethodPost,
+			path:       "/blog/blog/v1/post",
+			wantStatus: http.StatusNotFound,
+			want:       `{"code":5,"message":"Not Found","details":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			require.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+			require.JSONEq(t, tt.want, rec.Body.String())
+		})
+	}
+}
+
+func TestServerInvalidFixture(t *testing.T) {
+	fixtures := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(fixtures, "blog"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(fixtures, "blog", "AllPost.json"), []byte(`{`), 0o600))
+
+	s := cosmosmock.New([]module.Module{blogModule}, cosmosmock.WithFixtures(fixtures))
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/blog/blog/v1/post", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Contains(t, body["message"], "invalid fixture")
+}
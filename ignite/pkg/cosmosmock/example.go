@@ -0,0 +1,94 @@
+package cosmosmock
+
+import (
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis"
+)
+
+const (
+	// paginationField is the field of the responses of the paginated queries.
+	paginationField = "pagination"
+
+	// maxExampleDepth limits the nesting of the example messages, which can be recursive.
+	maxExampleDepth = 5
+)
+
+// externalExamples are the examples of the common types defined outside of the modules.
+var externalExamples = map[string]func() any{
+	"cosmos.base.query.v1beta1.PageResponse": func() any {
+		return map[string]any{"next_key": nil, "total": "1"}
+	},
+	"cosmos.base.v1beta1.Coin": func() any {
+		return map[string]any{"denom": "stake", "amount": "1000"}
+	},
+	"cosmos.base.v1beta1.DecCoin": func() any {
+		return map[string]any{"denom": "stake", "amount": "1000.000000000000000000"}
+	},
+	"google.protobuf.Any": func() any {
+		return map[string]any{"@type": ""}
+	},
+	"google.protobuf.Timestamp": func() any {
+		return "1970-01-01T00:00:00Z"
+	},
+	"google.protobuf.Duration": func() any {
+		return "0s"
+	},
+}
+
+// exampleMessage returns an example of a message of a proto package encoded like the chain API does.
+// The fields are set with their proto names and placeholder values, and like the paginated queries
+// of the Cosmos SDK, the fields of a message with a pagination field are lists.
+func exampleMessage(pkg protoanalysis.Package, name string) map[string]any {
+	msg, err := pkg.MessageByName(name)
+	if err != nil {
+		return map[string]any{}
+	}
+	return exampleFields(pkg, msg, 0)
+}
+
+func exampleFields(pkg protoanalysis.Package, msg protoanalysis.Message, depth int) map[string]any {
+	example := make(map[string]any, len(msg.Fields))
+	_, paginated := msg.Fields[paginationField]
+	for name, fieldType := range msg.Fields {
+		value := exampleValue(pkg, name, fieldType, depth+1)
+		if paginated && name != paginationField {
+			value = []any{value}
+		}
+		example[name] = value
+	}
+	return example
+}
+
+func exampleValue(pkg protoanalysis.Package, name, fieldType string, depth int) any {
+	switch fieldType {
+	case "string":
+		return name
+	case "bytes":
+		return ""
+	case "bool":
+		return false
+	case "int32", "sint32", "sfixed32", "uint32", "fixed32", "float", "double":
+		return 0
+	case "int64", "sint64", "sfixed64", "uint64", "fixed64":
+		// The 64 bits integers are encoded as strings in JSON
+		return "0"
+	}
+
+	if example, ok := externalExamples[strings.TrimPrefix(fieldType, ".")]; ok {
+		return example()
+	}
+
+	// The messages of the package are referenced with or without
+	// the package name and the nested ones are named Parent_Child.
+	msgName := strings.TrimPrefix(fieldType, pkg.Name+".")
+	msg, err := pkg.MessageByName(strings.ReplaceAll(msgName, ".", "_"))
+	if err != nil {
+		// The enums and the messages of the other packages are unknown
+		return nil
+	}
+	if depth > maxExampleDepth {
+		return map[string]any{}
+	}
+	return exampleFields(pkg, msg, depth)
+}
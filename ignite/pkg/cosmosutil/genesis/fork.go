@@ -0,0 +1,241 @@
+package genesis
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	ed25519PubKeyType = "/cosmos.crypto.ed25519.PubKey"
+	valoperSuffix     = "valoper"
+	valconsSuffix     = "valcons"
+)
+
+type (
+	lastValidatorPower struct {
+		Address string `json:"address"`
+		Power   string `json:"power"`
+	}
+
+	consensusPubKey struct {
+		Type string `json:"@type"`
+		Key  string `json:"key"`
+	}
+
+	signingInfo struct {
+		Address string               `json:"address"`
+		Info    validatorSigningInfo `json:"validator_signing_info"`
+	}
+
+	validatorSigningInfo struct {
+		Address             string `json:"address"`
+		StartHeight         string `json:"start_height"`
+		IndexOffset         string `json:"index_offset"`
+		JailedUntil         string `json:"jailed_until"`
+		Tombstoned          bool   `json:"tombstoned"`
+		MissedBlocksCounter string `json:"missed_blocks_counter"`
+	}
+)
+
+// SetSingleValidator rewrites an exported genesis for its chain to be started by a single
+// validator signing with the consensus key. The consensus key replaces the key of the bonded
+// validator with the most voting power, which becomes the only validator of the chain, so
+// that a node holding the key can produce blocks from the exported state.
+func SetSingleValidator(path string, pubKey ed25519.PubKey) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return errors.Errorf("invalid genesis %s: %w", path, err)
+	}
+
+	// The validators of the chain are the ones returned by the app
+	// when the consensus validators of the genesis are empty.
+	if _, ok := doc["validators"]; ok {
+		doc["validators"] = json.RawMessage("[]")
+	}
+	if err := updateObject(doc, "consensus", func(consensus map[string]json.RawMessage) error {
+		consensus["validators"] = json.RawMessage("[]")
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	var operatorAddress string
+	err = updateObject(doc, "app_state", func(appState map[string]json.RawMessage) error {
+		if err := updateObject(appState, "staking", func(staking map[string]json.RawMessage) error {
+			operatorAddress, err = setStakingValidator(staking, pubKey)
+			return err
+		}); err != nil {
+			return err
+		}
+
+		return updateObject(appState, "slashing", func(slashing map[string]json.RawMessage) error {
+			return addSigningInfo(slashing, operatorAddress, pubKey)
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if operatorAddress == "" {
+		return errors.New("the genesis has no staking state")
+	}
+
+	data, err = json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// setStakingValidator sets the consensus key of the validator with the most voting power
+// and makes it the only validator of the last validator set.
+// It returns the operator address of the validator.
+func setStakingValidator(staking map[string]json.RawMessage, pubKey ed25519.PubKey) (string, error) {
+	var powers []lastValidatorPower
+	if err := decodeField(staking, "last_validator_powers", &powers); err != nil {
+		return "", err
+	}
+
+	var (
+		top      lastValidatorPower
+		topPower int64
+	)
+	for _, p := range powers {
+		power, err := strconv.ParseInt(p.Power, 10, 64)
+		if err != nil {
+			return "", errors.Errorf("invalid power of validator %s: %w", p.Address, err)
+		}
+		if power > topPower {
+			top, topPower = p, power
+		}
+	}
+	if top.Address == "" {
+		return "", errors.New("the genesis has no bonded validator, make sure it is an exported genesis")
+	}
+
+	var validators []map[string]json.RawMessage
+	if err := decodeField(staking, "validators", &validators); err != nil {
+		return "", err
+	}
+
+	var found bool
+	for _, v := range validators {
+		var address string
+		if err := decodeField(v, "operator_address", &address); err != nil {
+			return "", err
+		}
+		if address != top.Address {
+			continue
+		}
+
+		key, err := json.Marshal(consensusPubKey{
+			Type: ed25519PubKeyType,
+			Key:  base64.StdEncoding.EncodeToString(pubKey),
+		})
+		if err != nil {
+			return "", err
+		}
+		v["consensus_pubkey"] = key
+		found = true
+	}
+	if !found {
+		return "", errors.Errorf("bonded validator %s not found in the genesis", top.Address)
+	}
+
+	if err := encodeField(staking, "validators", validators); err != nil {
+		return "", err
+	}
+	if err := encodeField(staking, "last_validator_powers", []lastValidatorPower{top}); err != nil {
+		return "", err
+	}
+	if err := encodeField(staking, "last_total_power", top.Power); err != nil {
+		return "", err
+	}
+	return top.Address, nil
+}
+
+// addSigningInfo adds the signing info of the consensus key required to handle its signatures.
+func addSigningInfo(slashing map[string]json.RawMessage, operatorAddress string, pubKey ed25519.PubKey) error {
+	hrp, _, err := bech32.DecodeAndConvert(operatorAddress)
+	if err != nil {
+		return errors.Errorf("invalid operator address %s: %w", operatorAddress, err)
+	}
+
+	consAddress, err := bech32.ConvertAndEncode(
+		strings.TrimSuffix(hrp, valoperSuffix)+valconsSuffix,
+		pubKey.Address(),
+	)
+	if err != nil {
+		return err
+	}
+
+	var infos []json.RawMessage
+	if err := decodeField(slashing, "signing_infos", &infos); err != nil {
+		return err
+	}
+
+	info, err := json.Marshal(signingInfo{
+		Address: consAddress,
+		Info: validatorSigningInfo{
+			Address:             consAddress,
+			StartHeight:         "0",
+			IndexOffset:         "0",
+			JailedUntil:         "1970-01-01T00:00:00Z",
+			MissedBlocksCounter: "0",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return encodeField(slashing, "signing_infos", append(infos, info))
+}
+
+// updateObject updates the JSON object of a field when the field exists.
+func updateObject(doc map[string]json.RawMessage, field string, update func(map[string]json.RawMessage) error) error {
+	if _, ok := doc[field]; !ok {
+		return nil
+	}
+
+	var obj map[string]json.RawMessage
+	if err := decodeField(doc, field, &obj); err != nil {
+		return err
+	}
+	if err := update(obj); err != nil {
+		return err
+	}
+	return encodeField(doc, field, obj)
+}
+
+func decodeField(doc map[string]json.RawMessage, field string, v any) error {
+	data, ok := doc[field]
+	if !ok || string(data) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errors.Errorf("invalid genesis field %s: %w", field, err)
+	}
+	return nil
+}
+
+func encodeField(doc map[string]json.RawMessage, field string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	doc[field] = data
+	return nil
+}
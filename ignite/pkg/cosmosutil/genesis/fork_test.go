@@ -0,0 +1,125 @@
+package genesis_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+
+	cosmosgenesis "github.com/ignite/cli/v29/ignite/pkg/cosmosutil/genesis"
+)
+
+const exportedGenesis = `{
+  "chain_id": "mars-1",
+  "initial_height": "101",
+  "app_state": {
+    "staking": {
+      "last_total_power": "30",
+      "last_validator_powers": [
+        {"address": "cosmosvaloper1qyqszqgpqyqszqgpqyqszqgpqyqszqgph84tp0", "power": "10"},
+        {"address": "cosmosvaloper1qgpqyqszqgpqyqszqgpqyqszqgpqyqszxrnw2e", "power": "20"}
+      ],
+      "validators": [
+        {"operator_address": "cosmosvaloper1qyqszqgpqyqszqgpqyqszqgpqyqszqgph84tp0", "consensus_pubkey": {"@type": "/cosmos.crypto.ed25519.PubKey", "key": "a"}, "tokens": "10000000"},
+        {"operator_address": "cosmosvaloper1qgpqyqszqgpqyqszqgpqyqszqgpqyqszxrnw2e", "consensus_pubkey": {"@type": "/cosmos.crypto.ed25519.PubKey", "key": "b"}, "tokens": "20000000"}
+      ]
+    },
+    "slashing": {
+      "signing_infos": []
+    }
+  },
+  "consensus": {
+    "validators": [
+      {"address": "A", "power": "10"},
+      {"address": "B", "power": "20"}
+    ]
+  }
+}`
+
+func TestSetSingleValidator(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey().(ed25519.PubKey)
+
+	tests := []struct {
+		name    string
+		genesis string
+		err     string
+	}{
+		{
+			name:    "exported genesis",
+			genesis: exportedGenesis,
+		},
+		{
+			name:    "genesis without bonded validator",
+			genesis: `{"app_state": {"staking": {"last_validator_powers": [], "validators": []}}}`,
+			err:     "the genesis has no bonded validator, make sure it is an exported genesis",
+		},
+		{
+			name:    "genesis without staking state",
+			genesis: `{"app_state": {}}`,
+			err:     "the genesis has no staking state",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "genesis.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.genesis), 0o644))
+
+			err := cosmosgenesis.SetSingleValidator(path, pubKey)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			var genesis struct {
+				ChainID  string `json:"chain_id"`
+				AppState struct {
+					Staking struct {
+						LastTotalPower      string `json:"last_total_power"`
+						LastValidatorPowers []struct {
+							Address string `json:"address"`
+							Power   string `json:"power"`
+						} `json:"last_validator_powers"`
+						Validators []struct {
+							OperatorAddress string `json:"operator_address"`
+							ConsensusPubKey struct {
+								Key string `json:"key"`
+							} `json:"consensus_pubkey"`
+							Tokens string `json:"tokens"`
+						} `json:"validators"`
+					} `json:"staking"`
+					Slashing struct {
+						SigningInfos []struct {
+							Address string `json:"address"`
+						} `json:"signing_infos"`
+					} `json:"slashing"`
+				} `json:"app_state"`
+				Consensus struct {
+					Validators []json.RawMessage `json:"validators"`
+				} `json:"consensus"`
+			}
+			require.NoError(t, json.Unmarshal(data, &genesis))
+
+			staking := genesis.AppState.Staking
+			require.Equal(t, "mars-1", genesis.ChainID)
+			require.Empty(t, genesis.Consensus.Validators)
+			require.Equal(t, "20", staking.LastTotalPower)
+			require.Len(t, staking.LastValidatorPowers, 1)
+			require.Equal(t, "cosmosvaloper1qgpqyqszqgpqyqszqgpqyqszqgpqyqszxrnw2e", staking.LastValidatorPowers[0].Address)
+			require.Equal(t, "a", staking.Validators[0].ConsensusPubKey.Key)
+			require.Equal(t, base64.StdEncoding.EncodeToString(pubKey), staking.Validators[1].ConsensusPubKey.Key)
+			require.Equal(t, "20000000", staking.Validators[1].Tokens)
+			require.Len(t, genesis.AppState.Slashing.SigningInfos, 1)
+			require.Contains(t, genesis.AppState.Slashing.SigningInfos[0].Address, "cosmosvalcons1")
+		})
+	}
+}
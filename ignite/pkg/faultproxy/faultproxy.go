@@ -0,0 +1,240 @@
+// Package faultproxy provides a TCP proxy injecting network faults between
+// its clients and a target server: partitions, latency with jitter and drops.
+// The faults can be changed at any time while the proxy is serving.
+package faultproxy
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// bufferSize is the maximum size of a chunk of forwarded data.
+	bufferSize = 32 * 1024
+
+	// queueSize is the number of chunks that can be delayed on a connection
+	// before the proxy stops reading from it.
+	queueSize = 1024
+)
+
+// ErrDropped is returned when a connection is dropped because of the drop rate.
+var ErrDropped = errors.New("connection dropped")
+
+// Faults are the faults injected by a proxy.
+type Faults struct {
+	// Partitioned closes the open connections and refuses the new ones.
+	Partitioned bool
+
+	// Latency delays each chunk of forwarded data.
+	Latency time.Duration
+
+	// Jitter adds a random delay, between zero and the jitter, to the latency.
+	Jitter time.Duration
+
+	// DropRate is the probability, between 0 and 1, for each chunk of forwarded data to be lost.
+	// TCP peers can't recover from a gap in the stream, so a lost chunk closes its connection.
+	DropRate float64
+}
+
+// IsZero returns true when no fault is injected.
+func (f Faults) IsZero() bool {
+	return f == Faults{}
+}
+
+// Proxy is a TCP proxy forwarding the connections to a target address.
+type Proxy struct {
+	listener net.Listener
+	target   string
+
+	mu     sync.Mutex
+	faults Faults
+	conns  map[net.Conn]struct{}
+}
+
+// Listen creates a proxy listening on the address and forwarding the connections to the target.
+// Use port 0 in the address to listen on a random port.
+func Listen(address, target string) (*Proxy, error) {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	return &Proxy{
+		listener: l,
+		target:   target,
+		conns:    make(map[net.Conn]struct{}),
+	}, nil
+}
+
+// Addr returns the address the proxy is listening on.
+func (p *Proxy) Addr() string {
+	return p.listener.Addr().String()
+}
+
+// Target returns the address the connections are forwarded to.
+func (p *Proxy) Target() string {
+	return p.target
+}
+
+// Close stops listening for new connections.
+func (p *Proxy) Close() error {
+	return p.listener.Close()
+}
+
+// Faults returns the faults currently injected by the proxy.
+func (p *Proxy) Faults() Faults {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.faults
+}
+
+// SetFaults changes the faults injected by the proxy.
+// Partitioning the proxy closes its open connections.
+func (p *Proxy) SetFaults(f Faults) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.faults = f
+	if f.Partitioned {
+		for c := range p.conns {
+			c.Close()
+		}
+	}
+}
+
+// Serve accepts and forwards the connections until the context is canceled.
+func (p *Proxy) Serve(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		p.listener.Close()
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		for c := range p.conns {
+			c.Close()
+		}
+	}()
+
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if p.Faults().Partitioned {
+			conn.Close()
+			continue
+		}
+
+		go p.handle(ctx, conn)
+	}
+}
+
+func (p *Proxy) handle(ctx context.Context, client net.Conn) {
+	var d net.Dialer
+	server, err := d.DialContext(ctx, "tcp", p.target)
+	if err != nil {
+		client.Close()
+		return
+	}
+
+	p.track(client, server)
+	defer p.untrack(client, server)
+
+	errc := make(chan error, 2)
+	go func() { errc <- p.forward(server, client) }()
+	go func() { errc <- p.forward(client, server) }()
+
+	// A connection is fully closed as soon as one of its sides is closed
+	<-errc
+}
+
+func (p *Proxy) track(conns ...net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, c := range conns {
+		p.conns[c] = struct{}{}
+	}
+}
+
+func (p *Proxy) untrack(conns ...net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, c := range conns {
+		c.Close()
+		delete(p.conns, c)
+	}
+}
+
+// forward copies the data from src to dst with the faults of the proxy.
+// The delayed chunks are written by a separate goroutine so the latency
+// doesn't limit the throughput of the connection.
+func (p *Proxy) forward(dst io.Writer, src io.Reader) error {
+	type chunk struct {
+		data []byte
+		due  time.Time
+	}
+
+	var (
+		chunks = make(chan chunk, queueSize)
+		done   = make(chan error, 1)
+	)
+	go func() {
+		var err error
+		for c := range chunks {
+			// Once the writing fails, the queue is only drained to unblock the reader
+			if err != nil {
+				continue
+			}
+			time.Sleep(time.Until(c.due))
+			_, err = dst.Write(c.data)
+		}
+		done <- err
+	}()
+
+	buf := make([]byte, bufferSize)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			delay, drop := p.chunkFaults()
+			if drop {
+				close(chunks)
+				return ErrDropped
+			}
+			chunks <- chunk{
+				data: append([]byte(nil), buf[:n]...),
+				due:  time.Now().Add(delay),
+			}
+		}
+		if err != nil {
+			// Deliver the delayed chunks before closing the connection
+			close(chunks)
+			if werr := <-done; werr != nil {
+				return werr
+			}
+			return err
+		}
+	}
+}
+
+// chunkFaults returns the delay of a chunk of data and whether it must be dropped.
+func (p *Proxy) chunkFaults() (delay time.Duration, drop bool) {
+	f := p.Faults()
+	if f.DropRate > 0 && rand.Float64() < f.DropRate { //nolint:gosec // faults don't need a secure source
+		return 0, true
+	}
+
+	delay = f.Latency
+	if f.Jitter > 0 {
+		delay += rand.N(f.Jitter) //nolint:gosec // faults don't need a secure source
+	}
+	return delay, false
+}
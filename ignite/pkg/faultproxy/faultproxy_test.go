@@ -0,0 +1,131 @@
+package faultproxy_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/faultproxy"
+)
+
+// startEchoServer starts a server writing back the lines it receives.
+func startEchoServer(t *testing.T) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func startProxy(t *testing.T) *faultproxy.Proxy {
+	t.Helper()
+
+	p, err := faultproxy.Listen("127.0.0.1:0", startEchoServer(t))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = p.Serve(ctx) }()
+	return p
+}
+
+func echo(conn net.Conn, r *bufio.Reader) (string, error) {
+	if _, err := conn.Write([]byte("ping\n")); err != nil {
+		return "", err
+	}
+	return r.ReadString('\n')
+}
+
+func TestProxy(t *testing.T) {
+	p := startProxy(t)
+
+	conn, err := net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	line, err := echo(conn, bufio.NewReader(conn))
+	require.NoError(t, err)
+	require.Equal(t, "ping\n", line)
+}
+
+func TestProxyLatency(t *testing.T) {
+	p := startProxy(t)
+	p.SetFaults(faultproxy.Faults{
+		Latency: 100 * time.Millisecond,
+		Jitter:  50 * time.Millisecond,
+	})
+
+	conn, err := net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// The latency is added in both directions
+	start := time.Now()
+	line, err := echo(conn, bufio.NewReader(conn))
+	require.NoError(t, err)
+	require.Equal(t, "ping\n", line)
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestProxyPartition(t *testing.T) {
+	p := startProxy(t)
+
+	conn, err := net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+
+	_, err = echo(conn, r)
+	require.NoError(t, err)
+
+	// The open connections are closed
+	p.SetFaults(faultproxy.Faults{Partitioned: true})
+	_, err = echo(conn, r)
+	require.Error(t, err)
+
+	// The new connections are refused
+	conn, err = net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = echo(conn, bufio.NewReader(conn))
+	require.Error(t, err)
+
+	// The connections are forwarded again once healed
+	p.SetFaults(faultproxy.Faults{})
+	conn, err = net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = echo(conn, bufio.NewReader(conn))
+	require.NoError(t, err)
+}
+
+func TestProxyDrop(t *testing.T) {
+	p := startProxy(t)
+	p.SetFaults(faultproxy.Faults{DropRate: 1})
+
+	conn, err := net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = echo(conn, bufio.NewReader(conn))
+	require.Error(t, err)
+}
@@ -48,6 +48,9 @@ const (
 	// CommandTest represents go "test" command.
 	CommandTest = "test"
 
+	// CommandTool represents go "tool" command.
+	CommandTool = "tool"
+
 	// EnvGOARCH represents GOARCH variable.
 	EnvGOARCH = "GOARCH"
 	// EnvGOMOD represents GOMOD variable.
@@ -211,6 +214,18 @@ func Test(ctx context.Context, path string, flags []string, options ...exec.Opti
 	return exec.Exec(ctx, command, append(options, exec.StepOption(step.Workdir(path)))...)
 }
 
+// CoverHTML writes the HTML report of the coverage profile of the packages in path to out.
+func CoverHTML(ctx context.Context, path, profile, out string, options ...exec.Option) error {
+	command := []string{
+		Name(),
+		CommandTool,
+		"cover",
+		"-html=" + profile,
+		FlagOut, out,
+	}
+	return exec.Exec(ctx, command, append(options, exec.StepOption(step.Workdir(path)))...)
+}
+
 // Ldflags returns a combined ldflags set from flags.
 func Ldflags(flags ...string) string {
 	return strings.Join(flags, " ")
@@ -0,0 +1,86 @@
+package gotest
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MetricNsPerOp is the unit of the time per operation reported by the benchmarks.
+const MetricNsPerOp = "ns/op"
+
+// reProcs matches the GOMAXPROCS suffix of the name of a benchmark.
+var reProcs = regexp.MustCompile(`-\d+$`)
+
+// Benchmark is the result of a benchmark.
+type Benchmark struct {
+	// Package is the import path of the package of the benchmark.
+	Package string
+
+	// Name is the name of the benchmark, without the GOMAXPROCS suffix.
+	Name string
+
+	// Iterations is the number of iterations of the benchmark.
+	Iterations int
+
+	// Metrics are the values reported by the benchmark by unit, like "ns/op"
+	// or the custom metrics reported with testing.B.ReportMetric.
+	Metrics map[string]float64
+}
+
+// ParseBenchmarks parses the results of the benchmarks of the output of "go test -bench".
+// The lines that aren't benchmark results or package names are ignored.
+func ParseBenchmarks(r io.Reader) ([]Benchmark, error) {
+	var (
+		benchmarks []Benchmark
+		pkg        string
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if name, ok := strings.CutPrefix(line, "pkg: "); ok {
+			pkg = name
+			continue
+		}
+		if b, ok := parseBenchmark(line); ok {
+			b.Package = pkg
+			benchmarks = append(benchmarks, b)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return benchmarks, nil
+}
+
+// parseBenchmark parses a benchmark result line like:
+//
+//	BenchmarkFoo-8   1000   1234 ns/op   56 gas/op
+func parseBenchmark(line string) (Benchmark, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || len(fields)%2 != 0 || !strings.HasPrefix(fields[0], "Benchmark") {
+		return Benchmark{}, false
+	}
+
+	iterations, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return Benchmark{}, false
+	}
+
+	b := Benchmark{
+		Name:       reProcs.ReplaceAllString(fields[0], ""),
+		Iterations: iterations,
+		Metrics:    make(map[string]float64),
+	}
+	for i := 2; i < len(fields); i += 2 {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return Benchmark{}, false
+		}
+		b.Metrics[fields[i+1]] = value
+	}
+	return b, true
+}
@@ -0,0 +1,49 @@
+package gotest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/gotest"
+)
+
+const benchOutput = `goos: linux
+goarch: amd64
+pkg: example.com/app/x/foo/integration
+cpu: AMD EPYC 7B13
+BenchmarkMsgCreatePost-8   	     412	   2903177 ns/op	     61234 gas/op
+BenchmarkMsgDeletePost     	     398	   3012775 ns/op	     58012.5 gas/op
+--- FAIL: BenchmarkMsgBroken
+PASS
+ok  	example.com/app/x/foo/integration	3.524s
+pkg: example.com/app/x/bar/integration
+BenchmarkMsgPing-16   	     1	   1200 ns/op
+ok  	example.com/app/x/bar/integration	0.524s
+`
+
+func TestParseBenchmarks(t *testing.T) {
+	benchmarks, err := gotest.ParseBenchmarks(strings.NewReader(benchOutput))
+	require.NoError(t, err)
+	require.Equal(t, []gotest.Benchmark{
+		{
+			Package:    "example.com/app/x/foo/integration",
+			Name:       "BenchmarkMsgCreatePost",
+			Iterations: 412,
+			Metrics:    map[string]float64{gotest.MetricNsPerOp: 2903177, "gas/op": 61234},
+		},
+		{
+			Package:    "example.com/app/x/foo/integration",
+			Name:       "BenchmarkMsgDeletePost",
+			Iterations: 398,
+			Metrics:    map[string]float64{gotest.MetricNsPerOp: 3012775, "gas/op": 58012.5},
+		},
+		{
+			Package:    "example.com/app/x/bar/integration",
+			Name:       "BenchmarkMsgPing",
+			Iterations: 1,
+			Metrics:    map[string]float64{gotest.MetricNsPerOp: 1200},
+		},
+	}, benchmarks)
+}
@@ -0,0 +1,134 @@
+package gotest
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"golang.org/x/tools/cover"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// Coverage is the statement coverage of a set of source files.
+type Coverage struct {
+	Statements int
+	Covered    int
+}
+
+// Percent returns the percentage of covered statements.
+func (c Coverage) Percent() float64 {
+	if c.Statements == 0 {
+		return 0
+	}
+	return float64(c.Covered) * 100 / float64(c.Statements)
+}
+
+// CoverageBy returns the statement coverage of the profiles grouped by the key returned by
+// group for the import path of each source file. Files with an empty key are ignored.
+func CoverageBy(profiles []*cover.Profile, group func(file string) string) map[string]Coverage {
+	coverage := make(map[string]Coverage)
+	for _, p := range profiles {
+		key := group(p.FileName)
+		if key == "" {
+			continue
+		}
+
+		c := coverage[key]
+		for _, b := range p.Blocks {
+			c.Statements += b.NumStmt
+			if b.Count > 0 {
+				c.Covered += b.NumStmt
+			}
+		}
+		coverage[key] = c
+	}
+	return coverage
+}
+
+// MergeProfiles merges the coverage profiles of the files and writes the result to w.
+// The counts of the blocks are added, the profiles must have the same mode.
+func MergeProfiles(w io.Writer, files ...string) ([]*cover.Profile, error) {
+	var (
+		mode   string
+		merged = make(map[string]*cover.Profile)
+	)
+	for _, file := range files {
+		profiles, err := cover.ParseProfiles(file)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range profiles {
+			if mode == "" {
+				mode = p.Mode
+			} else if p.Mode != mode {
+				return nil, errors.Errorf("cannot merge the coverage profile %s with mode %s into mode %s", file, p.Mode, mode)
+			}
+
+			m, ok := merged[p.FileName]
+			if !ok {
+				merged[p.FileName] = p
+				continue
+			}
+			m.Blocks = mergeBlocks(m.Blocks, p.Blocks, mode)
+		}
+	}
+
+	result := make([]*cover.Profile, 0, len(merged))
+	for _, p := range merged {
+		result = append(result, p)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].FileName < result[j].FileName })
+
+	if mode == "" {
+		return result, nil
+	}
+	if _, err := fmt.Fprintf(w, "mode: %s\n", mode); err != nil {
+		return nil, err
+	}
+	for _, p := range result {
+		for _, b := range p.Blocks {
+			_, err := fmt.Fprintf(w, "%s:%d.%d,%d.%d %d %d\n",
+				p.FileName, b.StartLine, b.StartCol, b.EndLine, b.EndCol, b.NumStmt, b.Count)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// mergeBlocks merges the blocks of two profiles of the same file.
+func mergeBlocks(a, b []cover.ProfileBlock, mode string) []cover.ProfileBlock {
+	type position struct {
+		startLine, startCol, endLine, endCol int
+	}
+
+	index := make(map[position]int, len(a))
+	for i, block := range a {
+		index[position{block.StartLine, block.StartCol, block.EndLine, block.EndCol}] = i
+	}
+
+	for _, block := range b {
+		i, ok := index[position{block.StartLine, block.StartCol, block.EndLine, block.EndCol}]
+		if !ok {
+			index[position{block.StartLine, block.StartCol, block.EndLine, block.EndCol}] = len(a)
+			a = append(a, block)
+			continue
+		}
+		if mode == "set" {
+			a[i].Count = max(a[i].Count, block.Count)
+		} else {
+			a[i].Count += block.Count
+		}
+	}
+
+	sort.Slice(a, func(i, j int) bool {
+		if a[i].StartLine != a[j].StartLine {
+			return a[i].StartLine < a[j].StartLine
+		}
+		return a[i].StartCol < a[j].StartCol
+	})
+	return a
+}
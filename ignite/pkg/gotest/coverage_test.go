@@ -0,0 +1,64 @@
+package gotest_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/gotest"
+)
+
+func TestMergeProfiles(t *testing.T) {
+	dir := t.TempDir()
+	unit := filepath.Join(dir, "unit.out")
+	require.NoError(t, os.WriteFile(unit, []byte(`mode: set
+example.com/app/x/foo/keeper/keeper.go:3.10,5.2 2 1
+example.com/app/x/foo/keeper/keeper.go:7.10,9.2 3 0
+example.com/app/x/bar/bar.go:3.18,3.28 1 0
+`), 0o600))
+	simulation := filepath.Join(dir, "simulation.out")
+	require.NoError(t, os.WriteFile(simulation, []byte(`mode: set
+example.com/app/x/foo/keeper/keeper.go:7.10,9.2 3 1
+example.com/app/x/foo/types/genesis.go:3.10,5.2 4 1
+`), 0o600))
+
+	var b bytes.Buffer
+	profiles, err := gotest.MergeProfiles(&b, unit, simulation)
+	require.NoError(t, err)
+	require.Equal(t, `mode: set
+example.com/app/x/bar/bar.go:3.18,3.28 1 0
+example.com/app/x/foo/keeper/keeper.go:3.10,5.2 2 1
+example.com/app/x/foo/keeper/keeper.go:7.10,9.2 3 1
+example.com/app/x/foo/types/genesis.go:3.10,5.2 4 1
+`, b.String())
+
+	coverage := gotest.CoverageBy(profiles, func(file string) string {
+		if !strings.HasPrefix(file, "example.com/app/x/") {
+			return ""
+		}
+		name, _, _ := strings.Cut(strings.TrimPrefix(file, "example.com/app/x/"), "/")
+		return name
+	})
+	require.Equal(t, map[string]gotest.Coverage{
+		"foo": {Statements: 9, Covered: 9},
+		"bar": {Statements: 1, Covered: 0},
+	}, coverage)
+	require.Equal(t, 100.0, coverage["foo"].Percent())
+	require.Equal(t, 0.0, coverage["bar"].Percent())
+}
+
+func TestMergeProfilesMode(t *testing.T) {
+	dir := t.TempDir()
+	set := filepath.Join(dir, "set.out")
+	require.NoError(t, os.WriteFile(set, []byte("mode: set\nexample.com/app/a.go:1.1,2.2 1 1\n"), 0o600))
+	count := filepath.Join(dir, "count.out")
+	require.NoError(t, os.WriteFile(count, []byte("mode: count\nexample.com/app/a.go:1.1,2.2 1 3\n"), 0o600))
+
+	var b bytes.Buffer
+	_, err := gotest.MergeProfiles(&b, set, count)
+	require.ErrorContains(t, err, "cannot merge the coverage profile")
+}
@@ -0,0 +1,220 @@
+// Package gotest parses the JSON output of "go test -json" to report the
+// results of the tests and their coverage, and the results of the benchmarks.
+package gotest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ActionRun is the action of the event of a test starting.
+	ActionRun = "run"
+
+	// ActionPass is the action of the event of a test or a package passing.
+	ActionPass = "pass"
+
+	// ActionFail is the action of the event of a test or a package failing.
+	ActionFail = "fail"
+
+	// ActionSkip is the action of the event of a skipped test or a package without tests.
+	ActionSkip = "skip"
+
+	// ActionOutput is the action of the event of an output line of a test or a package.
+	ActionOutput = "output"
+
+	// ActionBuildOutput is the action of the event of an output line of the build of a package.
+	ActionBuildOutput = "build-output"
+)
+
+// reCoverage matches the coverage of a package in its output.
+var reCoverage = regexp.MustCompile(`coverage: ([\d.]+)% of statements`)
+
+// Event is an event of the JSON output of go test, see "go doc test2json".
+type Event struct {
+	Time        time.Time `json:"Time"`
+	Action      string    `json:"Action"`
+	Package     string    `json:"Package"`
+	ImportPath  string    `json:"ImportPath"`
+	Test        string    `json:"Test"`
+	Elapsed     float64   `json:"Elapsed"`
+	Output      string    `json:"Output"`
+	FailedBuild string    `json:"FailedBuild"`
+}
+
+// Test is the result of a test.
+type Test struct {
+	Name    string
+	Status  string
+	Elapsed time.Duration
+	Output  string
+}
+
+// Package is the result of the tests of a package.
+type Package struct {
+	Name    string
+	Status  string
+	Elapsed time.Duration
+	Tests   []Test
+	Output  string
+
+	// Coverage is the percentage of statements covered by the tests of the
+	// package, it's negative when the coverage isn't reported.
+	Coverage float64
+
+	// Time is the time when the tests of the package started.
+	Time time.Time
+}
+
+// Count returns the number of tests of the package with the given status.
+func (p Package) Count(status string) int {
+	var n int
+	for _, t := range p.Tests {
+		if t.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Report is the result of the tests of packages.
+type Report struct {
+	Packages []Package
+}
+
+// Count returns the number of tests of the packages with the given status.
+func (r Report) Count(status string) int {
+	var n int
+	for _, p := range r.Packages {
+		n += p.Count(status)
+	}
+	return n
+}
+
+// Elapsed returns the total duration of the tests of the packages.
+func (r Report) Elapsed() time.Duration {
+	var d time.Duration
+	for _, p := range r.Packages {
+		d += p.Elapsed
+	}
+	return d
+}
+
+// Failed returns true when a package failed.
+func (r Report) Failed() bool {
+	for _, p := range r.Packages {
+		if p.Status == ActionFail {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse parses the JSON output of go test.
+// The lines that aren't test events are ignored, as well as the packages without tests.
+func Parse(r io.Reader) (Report, error) {
+	var (
+		packages    []*Package
+		index       = make(map[string]*Package)
+		tests       = make(map[string]map[string]int)
+		buildOutput = make(map[string]*strings.Builder)
+	)
+
+	getPackage := func(e Event) *Package {
+		p, ok := index[e.Package]
+		if !ok {
+			p = &Package{Name: e.Package, Coverage: -1, Time: e.Time}
+			index[e.Package] = p
+			tests[e.Package] = make(map[string]int)
+			packages = append(packages, p)
+		}
+		return p
+	}
+
+	getTest := func(p *Package, name string) *Test {
+		i, ok := tests[p.Name][name]
+		if !ok {
+			i = len(p.Tests)
+			p.Tests = append(p.Tests, Test{Name: name})
+			tests[p.Name][name] = i
+		}
+		return &p.Tests[i]
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if !bytes.HasPrefix(line, []byte("{")) {
+			continue
+		}
+
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			continue
+		}
+
+		if e.Action == ActionBuildOutput {
+			b, ok := buildOutput[e.ImportPath]
+			if !ok {
+				b = &strings.Builder{}
+				buildOutput[e.ImportPath] = b
+			}
+			b.WriteString(e.Output)
+			continue
+		}
+		if e.Package == "" {
+			continue
+		}
+
+		p := getPackage(e)
+		if e.Test == "" {
+			switch e.Action {
+			case ActionOutput:
+				p.Output += e.Output
+				if m := reCoverage.FindStringSubmatch(e.Output); m != nil {
+					p.Coverage, _ = strconv.ParseFloat(m[1], 64)
+				}
+			case ActionPass, ActionFail, ActionSkip:
+				p.Status = e.Action
+				p.Elapsed = seconds(e.Elapsed)
+				if b, ok := buildOutput[e.FailedBuild]; ok {
+					p.Output = b.String() + p.Output
+				}
+			}
+			continue
+		}
+
+		t := getTest(p, e.Test)
+		switch e.Action {
+		case ActionOutput:
+			t.Output += e.Output
+		case ActionPass, ActionFail, ActionSkip:
+			t.Status = e.Action
+			t.Elapsed = seconds(e.Elapsed)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Report{}, err
+	}
+
+	var report Report
+	for _, p := range packages {
+		// Skip the packages without tests, unless they failed to build
+		if len(p.Tests) == 0 && p.Status != ActionFail {
+			continue
+		}
+		report.Packages = append(report.Packages, *p)
+	}
+	return report, nil
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,84 @@
+package gotest_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/gotest"
+)
+
+const output = `# example.com/app/x/bar
+{"Time":"2024-01-02T03:04:05Z","Action":"start","Package":"example.com/app/x/foo/keeper"}
+{"Time":"2024-01-02T03:04:05Z","Action":"run","Package":"example.com/app/x/foo/keeper","Test":"TestAdd"}
+{"Time":"2024-01-02T03:04:05Z","Action":"output","Package":"example.com/app/x/foo/keeper","Test":"TestAdd","Output":"=== RUN   TestAdd\n"}
+{"Time":"2024-01-02T03:04:05Z","Action":"pass","Package":"example.com/app/x/foo/keeper","Test":"TestAdd","Elapsed":0.5}
+{"Time":"2024-01-02T03:04:05Z","Action":"run","Package":"example.com/app/x/foo/keeper","Test":"TestSub"}
+{"Time":"2024-01-02T03:04:05Z","Action":"output","Package":"example.com/app/x/foo/keeper","Test":"TestSub","Output":"    k_test.go:11: bad <result>\n"}
+{"Time":"2024-01-02T03:04:05Z","Action":"fail","Package":"example.com/app/x/foo/keeper","Test":"TestSub","Elapsed":0.25}
+{"Time":"2024-01-02T03:04:05Z","Action":"run","Package":"example.com/app/x/foo/keeper","Test":"TestLater"}
+{"Time":"2024-01-02T03:04:05Z","Action":"skip","Package":"example.com/app/x/foo/keeper","Test":"TestLater"}
+{"Time":"2024-01-02T03:04:05Z","Action":"output","Package":"example.com/app/x/foo/keeper","Output":"coverage: 42.5% of statements\n"}
+{"Time":"2024-01-02T03:04:05Z","Action":"fail","Package":"example.com/app/x/foo/keeper","Elapsed":1.5}
+{"Time":"2024-01-02T03:04:05Z","Action":"output","Package":"example.com/app/x/foo/types","Output":"?   \texample.com/app/x/foo/types\t[no test files]\n"}
+{"Time":"2024-01-02T03:04:05Z","Action":"skip","Package":"example.com/app/x/foo/types","Elapsed":0}
+{"ImportPath":"example.com/app/x/bar [example.com/app/x/bar.test]","Action":"build-output","Output":"x/bar/bar.go:3:1: syntax error\n"}
+{"ImportPath":"example.com/app/x/bar [example.com/app/x/bar.test]","Action":"build-fail"}
+{"Time":"2024-01-02T03:04:05Z","Action":"output","Package":"example.com/app/x/bar","Output":"FAIL\texample.com/app/x/bar [build failed]\n"}
+{"Time":"2024-01-02T03:04:05Z","Action":"fail","Package":"example.com/app/x/bar","Elapsed":0,"FailedBuild":"example.com/app/x/bar [example.com/app/x/bar.test]"}
+`
+
+func TestParse(t *testing.T) {
+	report, err := gotest.Parse(strings.NewReader(output))
+	require.NoError(t, err)
+
+	// The package without tests is skipped
+	require.Len(t, report.Packages, 2)
+	require.True(t, report.Failed())
+	require.Equal(t, 1, report.Count(gotest.ActionPass))
+	require.Equal(t, 1, report.Count(gotest.ActionFail))
+	require.Equal(t, 1, report.Count(gotest.ActionSkip))
+	require.Equal(t, 1500*time.Millisecond, report.Elapsed())
+
+	keeper := report.Packages[0]
+	require.Equal(t, "example.com/app/x/foo/keeper", keeper.Name)
+	require.Equal(t, gotest.ActionFail, keeper.Status)
+	require.Equal(t, 42.5, keeper.Coverage)
+	require.Equal(t, []gotest.Test{
+		{Name: "TestAdd", Status: gotest.ActionPass, Elapsed: 500 * time.Millisecond, Output: "=== RUN   TestAdd\n"},
+		{Name: "TestSub", Status: gotest.ActionFail, Elapsed: 250 * time.Millisecond, Output: "    k_test.go:11: bad <result>\n"},
+		{Name: "TestLater", Status: gotest.ActionSkip},
+	}, keeper.Tests)
+
+	// The build output is added to the output of the package
+	bar := report.Packages[1]
+	require.Equal(t, gotest.ActionFail, bar.Status)
+	require.Equal(t, -1.0, bar.Coverage)
+	require.Empty(t, bar.Tests)
+	require.Equal(t, "x/bar/bar.go:3:1: syntax error\nFAIL\texample.com/app/x/bar [build failed]\n", bar.Output)
+}
+
+func TestWriteJUnit(t *testing.T) {
+	report, err := gotest.Parse(strings.NewReader(output))
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, report.WriteJUnit(&b))
+
+	junit := b.String()
+	for _, s := range []string{
+		`<testsuites tests="4" failures="2" skipped="1" time="1.500">`,
+		`<testsuite name="example.com/app/x/foo/keeper" tests="3" failures="1" skipped="1" time="1.500" timestamp="2024-01-02T03:04:05Z">`,
+		`<testcase name="TestAdd" classname="example.com/app/x/foo/keeper" time="0.500"></testcase>`,
+		`<failure message="Failed"><![CDATA[    k_test.go:11: bad <result>`,
+		`<system-out><![CDATA[coverage: 42.5% of statements`,
+		// The package that doesn't build is reported as a failed test case
+		`<testcase name="[package]" classname="example.com/app/x/bar" time="0.000">`,
+		`<failure message="Failed"><![CDATA[x/bar/bar.go:3:1: syntax error`,
+	} {
+		require.Contains(t, junit, s)
+	}
+}
@@ -0,0 +1,113 @@
+package gotest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// junitFailedPackage is the name of the test case reporting a package that
+// failed without a failing test, like a package that doesn't build.
+const junitFailedPackage = "[package]"
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Cases     []junitTestCase `xml:"testcase"`
+	SystemOut *junitOutput    `xml:"system-out,omitempty"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitOutput struct {
+	Content string `xml:",cdata"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",cdata"`
+}
+
+// WriteJUnit writes the report in the JUnit XML format, with a test suite for each package.
+func (r Report) WriteJUnit(w io.Writer) error {
+	suites := junitTestSuites{Time: junitTime(r.Elapsed())}
+	for _, p := range r.Packages {
+		suite := junitTestSuite{
+			Name:     p.Name,
+			Time:     junitTime(p.Elapsed),
+			Failures: p.Count(ActionFail),
+			Skipped:  p.Count(ActionSkip),
+		}
+		if !p.Time.IsZero() {
+			suite.Timestamp = p.Time.UTC().Format(time.RFC3339)
+		}
+
+		for _, t := range p.Tests {
+			c := junitTestCase{
+				Name:      t.Name,
+				Classname: p.Name,
+				Time:      junitTime(t.Elapsed),
+			}
+			switch t.Status {
+			case ActionFail:
+				c.Failure = &junitMessage{Message: "Failed", Content: t.Output}
+			case ActionSkip:
+				c.Skipped = &junitMessage{Message: "Skipped", Content: t.Output}
+			}
+			suite.Cases = append(suite.Cases, c)
+		}
+
+		if p.Status == ActionFail && suite.Failures == 0 {
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      junitFailedPackage,
+				Classname: p.Name,
+				Time:      junitTime(p.Elapsed),
+				Failure:   &junitMessage{Message: "Failed", Content: p.Output},
+			})
+			suite.Failures++
+		} else if p.Output != "" {
+			suite.SystemOut = &junitOutput{Content: p.Output}
+		}
+
+		suite.Tests = len(suite.Cases)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "\t")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
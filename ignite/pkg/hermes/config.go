@@ -0,0 +1,163 @@
+package hermes
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+type (
+	// Config is the Hermes config.
+	Config struct {
+		Global    Global        `toml:"global"`
+		Mode      Mode          `toml:"mode"`
+		REST      Service       `toml:"rest"`
+		Telemetry Service       `toml:"telemetry"`
+		Chains    []ChainConfig `toml:"chains"`
+	}
+
+	// Global is the global config of the relayer.
+	Global struct {
+		LogLevel string `toml:"log_level"`
+	}
+
+	// Mode defines what the relayer relays.
+	Mode struct {
+		Clients     ClientsMode `toml:"clients"`
+		Connections Enabled     `toml:"connections"`
+		Channels    Enabled     `toml:"channels"`
+		Packets     PacketsMode `toml:"packets"`
+	}
+
+	// Enabled enables a mode.
+	Enabled struct {
+		Enabled bool `toml:"enabled"`
+	}
+
+	// ClientsMode defines how the clients are relayed.
+	ClientsMode struct {
+		Enabled      bool `toml:"enabled"`
+		Refresh      bool `toml:"refresh"`
+		Misbehaviour bool `toml:"misbehaviour"`
+	}
+
+	// PacketsMode defines how the packets are relayed.
+	PacketsMode struct {
+		Enabled        bool   `toml:"enabled"`
+		ClearInterval  uint64 `toml:"clear_interval"`
+		ClearOnStart   bool   `toml:"clear_on_start"`
+		TxConfirmation bool   `toml:"tx_confirmation"`
+	}
+
+	// Service is a service served by the relayer.
+	Service struct {
+		Enabled bool   `toml:"enabled"`
+		Host    string `toml:"host"`
+		Port    uint64 `toml:"port"`
+	}
+
+	// ChainConfig is the config of a relayed chain.
+	ChainConfig struct {
+		ID             string      `toml:"id"`
+		Type           string      `toml:"type"`
+		RPCAddr        string      `toml:"rpc_addr"`
+		GRPCAddr       string      `toml:"grpc_addr"`
+		EventSource    EventSource `toml:"event_source"`
+		RPCTimeout     string      `toml:"rpc_timeout"`
+		TrustedNode    bool        `toml:"trusted_node"`
+		AccountPrefix  string      `toml:"account_prefix"`
+		KeyName        string      `toml:"key_name"`
+		KeyStoreType   string      `toml:"key_store_type"`
+		StorePrefix    string      `toml:"store_prefix"`
+		DefaultGas     uint64      `toml:"default_gas"`
+		MaxGas         uint64      `toml:"max_gas"`
+		GasPrice       GasPrice    `toml:"gas_price"`
+		GasMultiplier  float64     `toml:"gas_multiplier"`
+		MaxMsgNum      uint64      `toml:"max_msg_num"`
+		MaxTxSize      uint64      `toml:"max_tx_size"`
+		ClockDrift     string      `toml:"clock_drift"`
+		MaxBlockTime   string      `toml:"max_block_time"`
+		TrustingPeriod string      `toml:"trusting_period"`
+		TrustThreshold string      `toml:"trust_threshold"`
+	}
+
+	// EventSource defines how the relayer receives the events of a chain.
+	EventSource struct {
+		Mode       string `toml:"mode"`
+		URL        string `toml:"url"`
+		BatchDelay string `toml:"batch_delay"`
+	}
+
+	// GasPrice is the price paid by the relayer for the gas.
+	GasPrice struct {
+		Price float64 `toml:"price"`
+		Denom string  `toml:"denom"`
+	}
+)
+
+// DefaultConfig returns a config to relay the packets between local development chains.
+func DefaultConfig() Config {
+	return Config{
+		Global: Global{LogLevel: "info"},
+		Mode: Mode{
+			Clients:     ClientsMode{Enabled: true, Refresh: true},
+			Connections: Enabled{Enabled: true},
+			Channels:    Enabled{Enabled: true},
+			Packets: PacketsMode{
+				Enabled:       true,
+				ClearInterval: 100,
+				ClearOnStart:  true,
+			},
+		},
+		REST:      Service{Host: "127.0.0.1", Port: 3000},
+		Telemetry: Service{Host: "127.0.0.1", Port: 3001},
+	}
+}
+
+// DefaultChainConfig returns the config of a local development chain.
+// The websocket address is the RPC address with the websocket path.
+func DefaultChainConfig(id, rpcAddr, grpcAddr, wsAddr string) ChainConfig {
+	return ChainConfig{
+		ID:       id,
+		Type:     "CosmosSdk",
+		RPCAddr:  rpcAddr,
+		GRPCAddr: grpcAddr,
+		EventSource: EventSource{
+			Mode:       "push",
+			URL:        wsAddr,
+			BatchDelay: "500ms",
+		},
+		RPCTimeout:     "10s",
+		TrustedNode:    true,
+		AccountPrefix:  "cosmos",
+		KeyName:        "relayer",
+		KeyStoreType:   "Test",
+		StorePrefix:    "ibc",
+		DefaultGas:     100000,
+		MaxGas:         10000000,
+		GasPrice:       GasPrice{Denom: "stake"},
+		GasMultiplier:  1.5,
+		MaxMsgNum:      30,
+		MaxTxSize:      2097152,
+		ClockDrift:     "5s",
+		MaxBlockTime:   "30s",
+		TrustingPeriod: "14days",
+		TrustThreshold: "2/3",
+	}
+}
+
+// Save writes the config to a file.
+func (c Config) Save(path string) error {
+	data, err := toml.Marshal(c)
+	if err != nil {
+		return errors.Errorf("encoding hermes config: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
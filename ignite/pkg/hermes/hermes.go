@@ -0,0 +1,229 @@
+// Package hermes provides access to the Hermes IBC relayer binary.
+package hermes
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/truncatedbuffer"
+)
+
+// DefaultBinary is the default name of the Hermes binary.
+const DefaultBinary = "hermes"
+
+// maxErrorLogsLen is the maximum length of the logs wrapped in command errors.
+const maxErrorLogsLen = 4096
+
+const (
+	// OrderUnordered is the order of an unordered channel.
+	OrderUnordered = "unordered"
+
+	// OrderOrdered is the order of an ordered channel.
+	OrderOrdered = "ordered"
+)
+
+type (
+	// Hermes runs the Hermes commands with a config file.
+	Hermes struct {
+		binary     string
+		configPath string
+		stdout     io.Writer
+		stderr     io.Writer
+	}
+
+	// Option configures Hermes.
+	Option func(*Hermes)
+
+	// Channel is the channel of a port.
+	Channel struct {
+		ChannelID string `json:"channel_id"`
+		PortID    string `json:"port_id"`
+	}
+
+	// ChannelOptions defines the ends of a channel to create.
+	ChannelOptions struct {
+		AChain  string
+		APort   string
+		BChain  string
+		BPort   string
+		Version string
+		Order   string
+	}
+)
+
+// WithBinary sets the path of the Hermes binary.
+func WithBinary(binary string) Option {
+	return func(h *Hermes) {
+		h.binary = binary
+	}
+}
+
+// WithStdout sets the writer for the output of the relayer.
+func WithStdout(w io.Writer) Option {
+	return func(h *Hermes) {
+		h.stdout = w
+	}
+}
+
+// WithStderr sets the writer for the logs of the relayer.
+func WithStderr(w io.Writer) Option {
+	return func(h *Hermes) {
+		h.stderr = w
+	}
+}
+
+// New creates a new Hermes using the config file.
+func New(configPath string, options ...Option) Hermes {
+	h := Hermes{
+		binary:     DefaultBinary,
+		configPath: configPath,
+		stdout:     io.Discard,
+		stderr:     io.Discard,
+	}
+
+	for _, apply := range options {
+		apply(&h)
+	}
+
+	return h
+}
+
+// ConfigPath returns the path of the config file.
+func (h Hermes) ConfigPath() string {
+	return h.configPath
+}
+
+// AddKey adds the key of the relayer account for a chain.
+// An existing key with the same name is overwritten.
+func (h Hermes) AddKey(ctx context.Context, chainID, keyName, mnemonic string) error {
+	dir, err := os.MkdirTemp("", "hermes")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	mnemonicFile := filepath.Join(dir, "mnemonic")
+	if err := os.WriteFile(mnemonicFile, []byte(mnemonic), 0o600); err != nil {
+		return err
+	}
+
+	return h.run(
+		ctx,
+		nil,
+		"keys", "add",
+		"--chain", chainID,
+		"--key-name", keyName,
+		"--mnemonic-file", mnemonicFile,
+		"--overwrite",
+	)
+}
+
+// Channels returns the channels of a chain with a counterparty chain.
+func (h Hermes) Channels(ctx context.Context, chainID, counterpartyChainID string) ([]Channel, error) {
+	var out bytes.Buffer
+	err := h.run(
+		ctx,
+		&out,
+		"--json",
+		"query", "channels",
+		"--chain", chainID,
+		"--counterparty-chain", counterpartyChainID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []Channel
+	if err := decodeResult(&out, &channels); err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+// CreateChannel creates a channel between two chains with a new client and connection.
+func (h Hermes) CreateChannel(ctx context.Context, options ChannelOptions) error {
+	args := []string{
+		"create", "channel",
+		"--a-chain", options.AChain,
+		"--b-chain", options.BChain,
+		"--a-port", options.APort,
+		"--b-port", options.BPort,
+		"--new-client-connection",
+		"--yes",
+	}
+	if options.Version != "" {
+		args = append(args, "--channel-version", options.Version)
+	}
+	if options.Order != "" {
+		args = append(args, "--order", options.Order)
+	}
+	return h.run(ctx, nil, args...)
+}
+
+// Start starts relaying packets between the chains of the config.
+// It blocks until the context is canceled or the relayer fails.
+func (h Hermes) Start(ctx context.Context) error {
+	return h.run(ctx, nil, "start")
+}
+
+func (h Hermes) run(ctx context.Context, stdout io.Writer, args ...string) error {
+	if stdout == nil {
+		stdout = h.stdout
+	}
+
+	// a truncated buffer is used because the relayer logs
+	// to stderr while it is running and can log a lot
+	errb := truncatedbuffer.NewTruncatedBuffer(maxErrorLogsLen)
+
+	args = append([]string{"--config", h.configPath}, args...)
+	err := cmdrunner.
+		New(
+			cmdrunner.DefaultStdout(stdout),
+			cmdrunner.DefaultStderr(io.MultiWriter(h.stderr, errb)),
+		).
+		Run(ctx, step.New(step.Exec(h.binary, args...)))
+
+	return errors.Wrap(err, errb.GetBuffer().String())
+}
+
+// decodeResult decodes the result of a command with a JSON output.
+// The result is the last output line, the previous lines being logs.
+func decodeResult(r io.Reader, v any) error {
+	var result struct {
+		Result json.RawMessage `json:"result"`
+		Status string          `json:"status"`
+	}
+
+	var found bool
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "{") || !strings.Contains(line, `"result"`) {
+			continue
+		}
+		if err := json.Unmarshal([]byte(line), &result); err != nil {
+			return err
+		}
+		found = true
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("hermes: missing command result")
+	}
+	if result.Status != "success" {
+		return errors.Errorf("hermes: %s", result.Result)
+	}
+	return json.Unmarshal(result.Result, v)
+}
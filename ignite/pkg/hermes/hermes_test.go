@@ -0,0 +1,52 @@
+package hermes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeResult(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   []Channel
+		err    string
+	}{
+		{
+			name: "channels",
+			output: `{"timestamp":"Jan 01 00:00:00.000","level":"INFO","fields":{"message":"using default configuration"}}
+{"result":[{"channel_id":"channel-0","port_id":"blog"}],"status":"success"}
+`,
+			want: []Channel{{ChannelID: "channel-0", PortID: "blog"}},
+		},
+		{
+			name:   "no channels",
+			output: `{"result":[],"status":"success"}`,
+			want:   []Channel{},
+		},
+		{
+			name:   "error",
+			output: `{"result":"chain not found","status":"error"}`,
+			err:    `hermes: "chain not found"`,
+		},
+		{
+			name:   "missing result",
+			output: "some logs",
+			err:    "hermes: missing command result",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Channel
+			err := decodeResult(strings.NewReader(tt.output), &got)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
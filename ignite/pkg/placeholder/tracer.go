@@ -1,6 +1,7 @@
 package placeholder
 
 import (
+	"sort"
 	"strings"
 )
 
@@ -20,6 +21,16 @@ func (set iterableStringSet) Add(item string) {
 	set[item] = struct{}{}
 }
 
+// Sorted returns the elements of the set in ascending order.
+func (set iterableStringSet) Sorted() []string {
+	elements := make([]string, 0, len(set))
+	for key := range set {
+		elements = append(elements, key)
+	}
+	sort.Strings(elements)
+	return elements
+}
+
 // Option for configuring session.
 type Option func(*Tracer)
 
@@ -32,7 +43,7 @@ func WithAdditionalInfo(info string) Option {
 
 // New instantiates Session with provided options.
 func New(opts ...Option) *Tracer {
-	s := &Tracer{missing: iterableStringSet{}}
+	s := &Tracer{found: iterableStringSet{}, missing: iterableStringSet{}}
 	for _, opt := range opts {
 		opt(s)
 	}
@@ -48,6 +59,7 @@ type Replacer interface {
 
 // Tracer keeps track of missing placeholders or other issues related to file modification.
 type Tracer struct {
+	found          iterableStringSet
 	missing        iterableStringSet
 	miscErrors     []string
 	additionalInfo string
@@ -59,6 +71,7 @@ func (t *Tracer) ReplaceAll(content, placeholder, replacement string) string {
 		t.missing.Add(placeholder)
 		return content
 	}
+	t.found.Add(placeholder)
 	return strings.ReplaceAll(content, placeholder, replacement)
 }
 
@@ -70,6 +83,7 @@ func (t *Tracer) Replace(content, placeholder, replacement string) string {
 		t.missing.Add(placeholder)
 		return content
 	}
+	t.found.Add(placeholder)
 	return strings.Replace(content, placeholder, replacement, 1)
 }
 
@@ -86,6 +100,16 @@ func (t *Tracer) AppendMiscError(miscError string) {
 	t.miscErrors = append(t.miscErrors, miscError)
 }
 
+// Found returns the placeholders found during execution in ascending order.
+func (t *Tracer) Found() []string {
+	return t.found.Sorted()
+}
+
+// Missing returns the placeholders missing during execution in ascending order.
+func (t *Tracer) Missing() []string {
+	return t.missing.Sorted()
+}
+
 // Err if any of the placeholders were missing during execution.
 func (t *Tracer) Err() error {
 	// miscellaneous errors represent errors preventing source modification not related to missing placeholder
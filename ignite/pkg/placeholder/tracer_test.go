@@ -97,3 +97,13 @@ func TestReplaceAll(t *testing.T) {
 		})
 	}
 }
+
+func TestFoundAndMissing(t *testing.T) {
+	tr := New()
+	content := tr.Replace("#two #one", "#one", "")
+	content = tr.ReplaceAll(content, "#two", "")
+	_ = tr.Replace(content, "#three", "")
+
+	require.Equal(t, []string{"#one", "#two"}, tr.Found())
+	require.Equal(t, []string{"#three"}, tr.Missing())
+}
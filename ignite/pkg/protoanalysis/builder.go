@@ -152,7 +152,11 @@ func (b builder) elementsToHTTPRules(requestMessage *proto.Message, elems []prot
 			continue
 		}
 
-		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, option.Constant)...)
+		// The method is part of the option name when the endpoint is the option
+		// value, e.g. option (google.api.http).get = "/endpoint".
+		_, method, _ := strings.Cut(option.Name, "google.api.http).")
+
+		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, option.Constant, method)...)
 	}
 
 	return
@@ -164,7 +168,7 @@ func (b builder) elementsToHTTPRules(requestMessage *proto.Message, elems []prot
 // defined after an "=", for example as "{param=**}".
 var urlParamRe = regexp.MustCompile(`(?m){([^=]+?)(?:=.+?)?}`)
 
-func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant proto.Literal) (httpRules []HTTPRule) {
+func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant proto.Literal, method string) (httpRules []HTTPRule) {
 	// find out the endpoint template.
 	endpoint := constant.Source
 
@@ -178,6 +182,7 @@ func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant pro
 				"patch",
 				"delete":
 				endpoint = val.Source
+				method = key
 			}
 			if endpoint != "" {
 				break
@@ -212,6 +217,8 @@ func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant pro
 
 	// create and add the HTTP rule to the list.
 	httpRule := HTTPRule{
+		Method:   strings.ToUpper(method),
+		Endpoint: endpoint,
 		Params:   params,
 		HasQuery: queryParamsCount > 0,
 		HasBody:  bodyFieldsCount > 0,
@@ -221,7 +228,7 @@ func (b builder) constantToHTTPRules(requestMessage *proto.Message, constant pro
 
 	// search for nested HTTP rules.
 	if constant, ok := constant.Map["additional_bindings"]; ok {
-		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, *constant)...)
+		httpRules = append(httpRules, b.constantToHTTPRules(requestMessage, *constant, "")...)
 	}
 
 	return httpRules
@@ -144,6 +144,12 @@ type (
 
 	// HTTPRule keeps info about a configured http rule of an RPC func.
 	HTTPRule struct {
+		// Method is the HTTP method of the endpoint, e.g. GET.
+		Method string `json:"method,omitempty"`
+
+		// Endpoint is the URL template of the endpoint, e.g. /cosmos/bank/v1beta1/balances/{address}.
+		Endpoint string `json:"endpoint,omitempty"`
+
 		// Params is a list of parameters defined in the HTTP endpoint itself.
 		Params []string `json:"params,omitempty"`
 
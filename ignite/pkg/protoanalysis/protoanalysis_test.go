@@ -307,8 +307,10 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "MsgCreatePoolResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params:  []string{"test"},
-									HasBody: true,
+									Method:   "POST",
+									Endpoint: "/liquidity/pools/{test}",
+									Params:   []string{"test"},
+									HasBody:  true,
 								},
 							},
 						},
@@ -318,8 +320,10 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "MsgDepositWithinBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params:  []string{"pool_id"},
-									HasBody: true,
+									Method:   "POST",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/deposits",
+									Params:   []string{"pool_id"},
+									HasBody:  true,
 								},
 							},
 						},
@@ -329,8 +333,10 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "MsgWithdrawWithinBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params:  []string{"pool_id"},
-									HasBody: true,
+									Method:   "POST",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/withdraws",
+									Params:   []string{"pool_id"},
+									HasBody:  true,
 								},
 							},
 						},
@@ -340,6 +346,8 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "MsgSwapWithinBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Method:   "POST",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/swaps",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 									HasBody:  true,
@@ -357,6 +365,8 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryLiquidityPoolsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Method:   "GET",
+									Endpoint: "/liquidity/pools",
 									HasQuery: true,
 								},
 							},
@@ -367,7 +377,9 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryLiquidityPoolResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id"},
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}",
+									Params:   []string{"pool_id"},
 								},
 							},
 						},
@@ -377,7 +389,9 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryLiquidityPoolBatchResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id"},
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch",
+									Params:   []string{"pool_id"},
 								},
 							},
 						},
@@ -387,6 +401,8 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchSwapMsgsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/swaps",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 								},
@@ -398,7 +414,9 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchSwapMsgResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id", "msg_index"},
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/swaps/{msg_index}",
+									Params:   []string{"pool_id", "msg_index"},
 								},
 							},
 						},
@@ -408,6 +426,8 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchDepositMsgsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/deposits",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 								},
@@ -419,7 +439,9 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchDepositMsgResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id", "msg_index"},
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/deposits/{msg_index}",
+									Params:   []string{"pool_id", "msg_index"},
 								},
 							},
 						},
@@ -429,6 +451,8 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchWithdrawMsgsResponse",
 							HTTPRules: []HTTPRule{
 								{
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/withdraws",
 									Params:   []string{"pool_id"},
 									HasQuery: true,
 								},
@@ -440,7 +464,9 @@ func TestLiquidity(t *testing.T) {
 							ReturnsType: "QueryPoolBatchWithdrawMsgResponse",
 							HTTPRules: []HTTPRule{
 								{
-									Params: []string{"pool_id", "msg_index"},
+									Method:   "GET",
+									Endpoint: "/liquidity/pools/{pool_id}/batch/withdraws/{msg_index}",
+									Params:   []string{"pool_id", "msg_index"},
 								},
 							},
 						},
@@ -449,7 +475,10 @@ func TestLiquidity(t *testing.T) {
 							RequestType: "QueryParamsRequest",
 							ReturnsType: "QueryParamsResponse",
 							HTTPRules: []HTTPRule{
-								{},
+								{
+									Method:   "GET",
+									Endpoint: "/liquidity/params",
+								},
 							},
 						},
 					},
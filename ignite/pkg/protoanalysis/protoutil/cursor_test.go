@@ -561,3 +561,51 @@ func TestGetNextId(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 6, NextUniqueID(m))
 }
+
+func TestRemoveElements(t *testing.T) {
+	f, err := parseStringProto(`syntax = "proto3"
+
+	import "this.proto";
+	import "that.proto";
+
+	service Msg {
+		rpc Foo(Bar) returns (Bar) {}
+		rpc Baz(Bar) returns (Bar) {}
+	}
+
+	message Bar {
+		string foo = 1;
+		oneof packet {
+			string baz = 2;
+			string qux = 3;
+		}
+	}
+
+	message Qux {}
+	`)
+	require.NoError(t, err)
+
+	require.True(t, RemoveImport(f, "that.proto"))
+	require.False(t, RemoveImport(f, "that.proto"))
+	require.True(t, HasImport(f, "this.proto"))
+	require.False(t, HasImport(f, "that.proto"))
+
+	s, err := GetServiceByName(f, "Msg")
+	require.NoError(t, err)
+	require.True(t, RemoveRPC(s, "Baz"))
+	require.False(t, RemoveRPC(s, "Baz"))
+	require.Len(t, s.Elements, 1)
+
+	m, err := GetMessageByName(f, "Bar")
+	require.NoError(t, err)
+	require.True(t, RemoveField(m, "foo"))
+	require.True(t, RemoveField(m, "qux"))
+	require.False(t, RemoveField(m, "unknown"))
+	require.False(t, HasField(f, "Bar", "foo"))
+	require.Len(t, m.Elements[0].(*proto.Oneof).Elements, 1)
+
+	require.True(t, RemoveMessage(f, "Qux"))
+	require.False(t, RemoveMessage(f, "Qux"))
+	require.False(t, HasMessage(f, "Qux"))
+	require.True(t, HasMessage(f, "Bar"))
+}
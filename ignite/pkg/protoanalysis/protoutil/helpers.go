@@ -341,3 +341,75 @@ func HasField(f *proto.Proto, messageName, field string) bool {
 	_, err = GetFieldByName(msg, field)
 	return err == nil
 }
+
+// RemoveMessage removes the top level message with the given name and returns true
+// if the message was found:
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	RemoveMessage(f, "Foo") // true if 'foo.proto' contained message Foo { ... }
+func RemoveMessage(f *proto.Proto, name string) bool {
+	return removeElements(&f.Elements, func(v proto.Visitee) bool {
+		m, ok := v.(*proto.Message)
+		return ok && m.Name == name
+	})
+}
+
+// RemoveRPC removes the RPC with the given name from the service and returns true
+// if the RPC was found:
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	s, _ := GetServiceByName(f, "Msg")
+//	RemoveRPC(s, "Foo") // true if the service contained rpc Foo(...) returns (...)
+func RemoveRPC(s *proto.Service, name string) bool {
+	return removeElements(&s.Elements, func(v proto.Visitee) bool {
+		rpc, ok := v.(*proto.RPC)
+		return ok && rpc.Name == name
+	})
+}
+
+// RemoveField removes the field with the given name from the message, including
+// the fields of its oneofs, and returns true if the field was found:
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	m, _ := GetMessageByName(f, "Foo")
+//	RemoveField(m, "bar") // true if the message contained the bar field
+func RemoveField(m *proto.Message, name string) bool {
+	removed := removeElements(&m.Elements, func(v proto.Visitee) bool {
+		field, ok := v.(*proto.NormalField)
+		return ok && field.Name == name
+	})
+	for _, el := range m.Elements {
+		if oneof, ok := el.(*proto.Oneof); ok {
+			removed = removeElements(&oneof.Elements, func(v proto.Visitee) bool {
+				field, ok := v.(*proto.OneOfField)
+				return ok && field.Name == name
+			}) || removed
+		}
+	}
+	return removed
+}
+
+// RemoveImport removes the import with the given path and returns true if the import was found:
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	RemoveImport(f, "other.proto") // true if 'foo.proto' contained import "other.proto"
+func RemoveImport(f *proto.Proto, path string) bool {
+	return removeElements(&f.Elements, func(v proto.Visitee) bool {
+		imp, ok := v.(*proto.Import)
+		return ok && imp.Filename == path
+	})
+}
+
+// removeElements removes the elements matching the given function and returns true
+// if at least one element has been removed.
+func removeElements(elements *[]proto.Visitee, match func(proto.Visitee) bool) bool {
+	kept := make([]proto.Visitee, 0, len(*elements))
+	for _, el := range *elements {
+		if !match(el) {
+			kept = append(kept, el)
+		}
+	}
+	removed := len(kept) != len(*elements)
+	*elements = kept
+	return removed
+}
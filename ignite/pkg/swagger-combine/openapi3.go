@@ -0,0 +1,707 @@
+package swaggercombine
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// OpenAPI3Version is the version of the OpenAPI specs converted from Swagger 2.0 specs.
+	OpenAPI3Version = "3.1.0"
+
+	definitionsRefPrefix = "#/definitions/"
+	parametersRefPrefix  = "#/parameters/"
+	responsesRefPrefix   = "#/responses/"
+
+	defaultMediaType = "application/json"
+	formMediaType    = "application/x-www-form-urlencoded"
+	multipartType    = "multipart/form-data"
+	defaultScheme    = "https"
+)
+
+var (
+	// refPrefixes maps the Swagger 2.0 reference prefixes to the OpenAPI 3 ones.
+	refPrefixes = map[string]string{
+		definitionsRefPrefix: "#/components/schemas/",
+		parametersRefPrefix:  "#/components/parameters/",
+		responsesRefPrefix:   "#/components/responses/",
+	}
+
+	// paramSchemaFields are the fields of a Swagger 2.0 non body parameter
+	// that are moved to the schema of the OpenAPI 3 parameter.
+	paramSchemaFields = []string{
+		"type", "format", "items", "default", "enum", "example", "multipleOf",
+		"maximum", "exclusiveMaximum", "minimum", "exclusiveMinimum",
+		"maxLength", "minLength", "pattern", "maxItems", "minItems", "uniqueItems",
+	}
+
+	// collectionStyles maps the Swagger 2.0 collection formats of
+	// the array parameters to the OpenAPI 3 style and explode fields.
+	collectionStyles = map[string]struct {
+		style   string
+		explode bool
+	}{
+		"csv":   {"form", false},
+		"multi": {"form", true},
+		"ssv":   {"spaceDelimited", false},
+		"pipes": {"pipeDelimited", false},
+	}
+)
+
+// ToOpenAPI3 converts a Swagger 2.0 spec to an OpenAPI 3.1 spec.
+// The definitions become the component schemas and the body parameters become request bodies.
+// The schemas are kept as they are generated from the proto files, like the one of the
+// google.protobuf.Any messages, as well as the query parameters of the requests, like the
+// pagination ones, and the vendor extensions.
+func ToOpenAPI3(s *spec.Swagger) (map[string]any, error) {
+	doc := map[string]any{
+		"openapi": OpenAPI3Version,
+	}
+	copyExtensions(doc, s.Extensions)
+
+	if s.Info != nil {
+		info, err := toMap(s.Info)
+		if err != nil {
+			return nil, err
+		}
+		doc["info"] = info
+	} else {
+		doc["info"] = map[string]any{"title": "", "version": ""}
+	}
+
+	if servers := convertServers(s); len(servers) > 0 {
+		doc["servers"] = servers
+	}
+
+	if len(s.Tags) > 0 {
+		tags, err := toAny(s.Tags)
+		if err != nil {
+			return nil, err
+		}
+		doc["tags"] = tags
+	}
+	if s.ExternalDocs != nil {
+		externalDocs, err := toAny(s.ExternalDocs)
+		if err != nil {
+			return nil, err
+		}
+		doc["externalDocs"] = externalDocs
+	}
+	if len(s.Security) > 0 {
+		doc["security"] = s.Security
+	}
+
+	consumes := mediaTypes(s.Consumes)
+	produces := mediaTypes(s.Produces)
+
+	paths := make(map[string]any)
+	if s.Paths != nil {
+		copyExtensions(paths, s.Paths.Extensions)
+		for path, item := range s.Paths.Paths {
+			pathItem, err := convertPathItem(item, consumes, produces)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid path %s", path)
+			}
+			paths[path] = pathItem
+		}
+	}
+	doc["paths"] = paths
+
+	components, err := convertComponents(s, produces)
+	if err != nil {
+		return nil, err
+	}
+	if len(components) > 0 {
+		doc["components"] = components
+	}
+
+	return doc, nil
+}
+
+// convertServers returns the servers of the API from the host, base path and schemes of a spec.
+func convertServers(s *spec.Swagger) []any {
+	if s.Host == "" {
+		if s.BasePath == "" || s.BasePath == "/" {
+			return nil
+		}
+		return []any{map[string]any{"url": s.BasePath}}
+	}
+
+	schemes := s.Schemes
+	if len(schemes) == 0 {
+		schemes = []string{defaultScheme}
+	}
+
+	servers := make([]any, 0, len(schemes))
+	for _, scheme := range schemes {
+		servers = append(servers, map[string]any{
+			"url": fmt.Sprintf("%s://%s%s", scheme, s.Host, strings.TrimSuffix(s.BasePath, "/")),
+		})
+	}
+	return servers
+}
+
+// convertComponents converts the definitions, the parameters, the responses
+// and the security definitions of a spec to OpenAPI 3 components.
+func convertComponents(s *spec.Swagger, produces []string) (map[string]any, error) {
+	components := make(map[string]any)
+
+	if len(s.Definitions) > 0 {
+		schemas := make(map[string]any, len(s.Definitions))
+		for name, schema := range s.Definitions {
+			converted, err := convertSchema(schema)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid definition %s", name)
+			}
+			schemas[name] = converted
+		}
+		components["schemas"] = schemas
+	}
+
+	if len(s.Parameters) > 0 {
+		parameters := make(map[string]any, len(s.Parameters))
+		for name, param := range s.Parameters {
+			if param.In == "body" || param.In == "formData" {
+				return nil, errors.Errorf("%s parameter %s can't be shared with OpenAPI 3", param.In, name)
+			}
+			converted, err := convertParameter(param)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid parameter %s", name)
+			}
+			parameters[name] = converted
+		}
+		components["parameters"] = parameters
+	}
+
+	if len(s.Responses) > 0 {
+		responses := make(map[string]any, len(s.Responses))
+		for name, response := range s.Responses {
+			converted, err := convertResponse(response, produces)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid response %s", name)
+			}
+			responses[name] = converted
+		}
+		components["responses"] = responses
+	}
+
+	if len(s.SecurityDefinitions) > 0 {
+		schemes := make(map[string]any, len(s.SecurityDefinitions))
+		for name, scheme := range s.SecurityDefinitions {
+			schemes[name] = convertSecurityScheme(scheme)
+		}
+		components["securitySchemes"] = schemes
+	}
+
+	return components, nil
+}
+
+// convertPathItem converts the operations and the parameters of a path.
+func convertPathItem(item spec.PathItem, consumes, produces []string) (map[string]any, error) {
+	pathItem := make(map[string]any)
+	copyExtensions(pathItem, item.Extensions)
+
+	if item.Ref.String() != "" {
+		pathItem["$ref"] = item.Ref.String()
+	}
+
+	var bodyParams []spec.Parameter
+	if len(item.Parameters) > 0 {
+		params := make([]any, 0, len(item.Parameters))
+		for _, param := range item.Parameters {
+			// The body parameters are request bodies of each operation in OpenAPI 3
+			if param.In == "body" || param.In == "formData" {
+				bodyParams = append(bodyParams, param)
+				continue
+			}
+			converted, err := convertParameter(param)
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, converted)
+		}
+		if len(params) > 0 {
+			pathItem["parameters"] = params
+		}
+	}
+
+	operations := map[string]*spec.Operation{
+		"get":     item.Get,
+		"put":     item.Put,
+		"post":    item.Post,
+		"delete":  item.Delete,
+		"options": item.Options,
+		"head":    item.Head,
+		"patch":   item.Patch,
+	}
+	for method, op := range operations {
+		if op == nil {
+			continue
+		}
+		converted, err := convertOperation(op, bodyParams, consumes, produces)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s operation", method)
+		}
+		pathItem[method] = converted
+	}
+
+	return pathItem, nil
+}
+
+// convertOperation converts an operation with its parameters, request body and responses.
+func convertOperation(op *spec.Operation, pathBodyParams []spec.Parameter, consumes, produces []string) (map[string]any, error) {
+	operation := make(map[string]any)
+	copyExtensions(operation, op.Extensions)
+
+	if len(op.Tags) > 0 {
+		operation["tags"] = op.Tags
+	}
+	if op.Summary != "" {
+		operation["summary"] = op.Summary
+	}
+	if op.Description != "" {
+		operation["description"] = op.Description
+	}
+	if op.ID != "" {
+		operation["operationId"] = op.ID
+	}
+	if op.Deprecated {
+		operation["deprecated"] = true
+	}
+	if op.Security != nil {
+		operation["security"] = op.Security
+	}
+	if op.ExternalDocs != nil {
+		externalDocs, err := toAny(op.ExternalDocs)
+		if err != nil {
+			return nil, err
+		}
+		operation["externalDocs"] = externalDocs
+	}
+	if len(op.Consumes) > 0 {
+		consumes = op.Consumes
+	}
+	if len(op.Produces) > 0 {
+		produces = op.Produces
+	}
+
+	var (
+		params     []any
+		bodyParams = append([]spec.Parameter{}, pathBodyParams...)
+	)
+	for _, param := range op.Parameters {
+		if param.In == "body" || param.In == "formData" {
+			bodyParams = append(bodyParams, param)
+			continue
+		}
+		converted, err := convertParameter(param)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, converted)
+	}
+	if len(params) > 0 {
+		operation["parameters"] = params
+	}
+
+	if len(bodyParams) > 0 {
+		requestBody, err := convertRequestBody(bodyParams, consumes)
+		if err != nil {
+			return nil, err
+		}
+		operation["requestBody"] = requestBody
+	}
+
+	responses := make(map[string]any)
+	if op.Responses != nil {
+		copyExtensions(responses, op.Responses.Extensions)
+		if op.Responses.Default != nil {
+			converted, err := convertResponse(*op.Responses.Default, produces)
+			if err != nil {
+				return nil, err
+			}
+			responses["default"] = converted
+		}
+		for code, response := range op.Responses.StatusCodeResponses {
+			converted, err := convertResponse(response, produces)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid response %d", code)
+			}
+			responses[strconv.Itoa(code)] = converted
+		}
+	}
+	operation["responses"] = responses
+
+	return operation, nil
+}
+
+// convertParameter converts a path, query or header parameter.
+// The type and the validations of the parameter are moved to its schema.
+func convertParameter(param spec.Parameter) (map[string]any, error) {
+	if param.Ref.String() != "" {
+		return map[string]any{"$ref": convertRef(param.Ref.String())}, nil
+	}
+
+	fields, err := toMap(param)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := make(map[string]any)
+	for _, field := range paramSchemaFields {
+		if v, ok := fields[field]; ok {
+			schema[field] = v
+			delete(fields, field)
+		}
+	}
+	removeCollectionFormats(schema)
+
+	delete(fields, "collectionFormat")
+	if param.Type == "array" {
+		collectionFormat := param.CollectionFormat
+		if collectionFormat == "" {
+			collectionFormat = "csv"
+		}
+		if style, ok := collectionStyles[collectionFormat]; ok && param.In == "query" {
+			fields["style"] = style.style
+			fields["explode"] = style.explode
+		}
+	}
+	if param.In != "query" {
+		delete(fields, "allowEmptyValue")
+	}
+
+	fields["schema"] = convertSchemaValue(schema)
+	return fields, nil
+}
+
+// convertRequestBody converts the body or the form data parameters of an operation to a request body.
+func convertRequestBody(params []spec.Parameter, consumes []string) (map[string]any, error) {
+	requestBody := make(map[string]any)
+
+	for _, param := range params {
+		if param.In != "body" {
+			continue
+		}
+		if param.Description != "" {
+			requestBody["description"] = param.Description
+		}
+		if param.Required {
+			requestBody["required"] = true
+		}
+		copyExtensions(requestBody, param.Extensions)
+
+		var schema any = map[string]any{}
+		if param.Schema != nil {
+			converted, err := convertSchema(*param.Schema)
+			if err != nil {
+				return nil, err
+			}
+			schema = converted
+		}
+
+		content := make(map[string]any, len(consumes))
+		for _, mediaType := range consumes {
+			content[mediaType] = map[string]any{"schema": schema}
+		}
+		requestBody["content"] = content
+		return requestBody, nil
+	}
+
+	// The form data parameters are the properties of the request body
+	var (
+		properties = make(map[string]any)
+		required   []string
+		mediaType  = formMediaType
+	)
+	for _, param := range params {
+		param.In = "query"
+		converted, err := convertParameter(param)
+		if err != nil {
+			return nil, err
+		}
+		schema, _ := converted["schema"].(map[string]any)
+		if schema == nil {
+			schema = make(map[string]any)
+		}
+		if param.Description != "" {
+			schema["description"] = param.Description
+		}
+		if param.Type == "file" {
+			mediaType = multipartType
+		}
+		properties[param.Name] = schema
+		if param.Required {
+			required = append(required, param.Name)
+			requestBody["required"] = true
+		}
+	}
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		sort.Strings(required)
+		schema["required"] = required
+	}
+	requestBody["content"] = map[string]any{
+		mediaType: map[string]any{"schema": schema},
+	}
+	return requestBody, nil
+}
+
+// convertResponse converts a response with its schema as the content of each produced media type.
+func convertResponse(response spec.Response, produces []string) (map[string]any, error) {
+	if response.Ref.String() != "" {
+		return map[string]any{"$ref": convertRef(response.Ref.String())}, nil
+	}
+
+	converted := map[string]any{
+		"description": response.Description,
+	}
+	copyExtensions(converted, response.Extensions)
+
+	if response.Schema != nil {
+		schema, err := convertSchema(*response.Schema)
+		if err != nil {
+			return nil, err
+		}
+
+		content := make(map[string]any, len(produces))
+		for _, mediaType := range produces {
+			mediaTypeObject := map[string]any{"schema": schema}
+			if example, ok := response.Examples[mediaType]; ok {
+				mediaTypeObject["example"] = example
+			}
+			content[mediaType] = mediaTypeObject
+		}
+		converted["content"] = content
+	}
+
+	if len(response.Headers) > 0 {
+		headers := make(map[string]any, len(response.Headers))
+		for name, header := range response.Headers {
+			fields, err := toMap(header)
+			if err != nil {
+				return nil, err
+			}
+
+			schema := make(map[string]any)
+			for _, field := range paramSchemaFields {
+				if v, ok := fields[field]; ok {
+					schema[field] = v
+					delete(fields, field)
+				}
+			}
+			removeCollectionFormats(schema)
+			delete(fields, "collectionFormat")
+
+			fields["schema"] = convertSchemaValue(schema)
+			headers[name] = fields
+		}
+		converted["headers"] = headers
+	}
+
+	return converted, nil
+}
+
+// convertSecurityScheme converts a security definition to a security scheme.
+func convertSecurityScheme(scheme *spec.SecurityScheme) map[string]any {
+	converted := make(map[string]any)
+	copyExtensions(converted, scheme.Extensions)
+
+	if scheme.Description != "" {
+		converted["description"] = scheme.Description
+	}
+
+	switch scheme.Type {
+	case "basic":
+		converted["type"] = "http"
+		converted["scheme"] = "basic"
+	case "apiKey":
+		converted["type"] = "apiKey"
+		converted["name"] = scheme.Name
+		converted["in"] = scheme.In
+	case "oauth2":
+		flow := map[string]any{"scopes": scheme.Scopes}
+		if scheme.Scopes == nil {
+			flow["scopes"] = map[string]string{}
+		}
+
+		var flowName string
+		switch scheme.Flow {
+		case "implicit":
+			flowName = "implicit"
+			flow["authorizationUrl"] = scheme.AuthorizationURL
+		case "password":
+			flowName = "password"
+			flow["tokenUrl"] = scheme.TokenURL
+		case "application":
+			flowName = "clientCredentials"
+			flow["tokenUrl"] = scheme.TokenURL
+		case "accessCode":
+			flowName = "authorizationCode"
+			flow["authorizationUrl"] = scheme.AuthorizationURL
+			flow["tokenUrl"] = scheme.TokenURL
+		}
+
+		converted["type"] = "oauth2"
+		converted["flows"] = map[string]any{flowName: flow}
+	default:
+		converted["type"] = scheme.Type
+	}
+
+	return converted
+}
+
+// convertSchema converts a Swagger 2.0 schema to an OpenAPI 3.1 schema.
+func convertSchema(schema spec.Schema) (any, error) {
+	v, err := toAny(schema)
+	if err != nil {
+		return nil, err
+	}
+	return convertSchemaValue(v), nil
+}
+
+// convertSchemaValue converts the JSON value of a Swagger 2.0 schema to an OpenAPI 3.1 schema:
+// the references point to the components, the nullable types include the null type, the
+// exclusive bounds are numbers and the file types are binary strings.
+func convertSchemaValue(v any) any {
+	switch v := v.(type) {
+	case []any:
+		for i := range v {
+			v[i] = convertSchemaValue(v[i])
+		}
+		return v
+	case map[string]any:
+		for k, value := range v {
+			v[k] = convertSchemaValue(value)
+		}
+
+		if ref, ok := v["$ref"].(string); ok {
+			v["$ref"] = convertRef(ref)
+		}
+		if t, ok := v["type"].(string); ok && t == "file" {
+			v["type"] = "string"
+			v["format"] = "binary"
+		}
+		if discriminator, ok := v["discriminator"].(string); ok {
+			v["discriminator"] = map[string]any{"propertyName": discriminator}
+		}
+		convertExclusiveBound(v, "exclusiveMaximum", "maximum")
+		convertExclusiveBound(v, "exclusiveMinimum", "minimum")
+
+		if nullable, ok := v["x-nullable"].(bool); ok {
+			delete(v, "x-nullable")
+			if nullable {
+				setNullable(v)
+			}
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// convertExclusiveBound converts a boolean exclusive bound to the numeric exclusive bound of JSON Schema.
+func convertExclusiveBound(schema map[string]any, exclusiveField, boundField string) {
+	exclusive, ok := schema[exclusiveField].(bool)
+	if !ok {
+		return
+	}
+	delete(schema, exclusiveField)
+
+	bound, ok := schema[boundField]
+	if exclusive && ok {
+		schema[exclusiveField] = bound
+		delete(schema, boundField)
+	}
+}
+
+// setNullable makes a schema accept the null value.
+func setNullable(schema map[string]any) {
+	if ref, ok := schema["$ref"]; ok {
+		delete(schema, "$ref")
+		schema["anyOf"] = []any{
+			map[string]any{"$ref": ref},
+			map[string]any{"type": "null"},
+		}
+		return
+	}
+	if t, ok := schema["type"].(string); ok {
+		schema["type"] = []any{t, "null"}
+	}
+}
+
+// removeCollectionFormats removes the collection formats from the items of a parameter schema.
+func removeCollectionFormats(schema map[string]any) {
+	items, ok := schema["items"].(map[string]any)
+	if !ok {
+		return
+	}
+	delete(items, "collectionFormat")
+	removeCollectionFormats(items)
+}
+
+// convertRef converts a Swagger 2.0 local reference to an OpenAPI 3 component reference.
+func convertRef(ref string) string {
+	for prefix, componentPrefix := range refPrefixes {
+		if strings.HasPrefix(ref, prefix) {
+			return componentPrefix + strings.TrimPrefix(ref, prefix)
+		}
+	}
+	return ref
+}
+
+// mediaTypes returns the media types or the default media type when empty.
+func mediaTypes(types []string) []string {
+	if len(types) == 0 {
+		return []string{defaultMediaType}
+	}
+	return types
+}
+
+// copyExtensions copies the vendor extensions of a Swagger 2.0 object.
+func copyExtensions(dst map[string]any, extensions spec.Extensions) {
+	for k, v := range extensions {
+		if strings.HasPrefix(strings.ToLower(k), "x-") {
+			dst[k] = v
+		}
+	}
+}
+
+// toAny returns the JSON value of a value.
+func toAny(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// toMap returns the JSON object of a value.
+func toMap(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -1,6 +1,8 @@
 package swaggercombine
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -17,7 +19,13 @@ import (
 // Config represent swagger-combine config.
 type Config struct {
 	spec  *spec.Swagger
-	specs []*spec.Swagger
+	specs []addedSpec
+}
+
+// addedSpec is a spec added to the config with its unique id.
+type addedSpec struct {
+	id   string
+	spec *spec.Swagger
 }
 
 // New create a mew swagger combine config.
@@ -37,7 +45,7 @@ func New(title, name string) *Config {
 				Definitions: make(spec.Definitions),
 			},
 		},
-		specs: make([]*spec.Swagger, 0),
+		specs: make([]addedSpec, 0),
 	}
 }
 
@@ -61,7 +69,7 @@ func (c *Config) AddSpec(id, path string, makeUnique bool) error {
 		}
 	}
 
-	c.specs = append(c.specs, c.mergeTags(c.mergeDefinitions(spec)))
+	c.specs = append(c.specs, addedSpec{id: id, spec: spec})
 
 	return nil
 }
@@ -78,6 +86,83 @@ func (c *Config) mergeDefinitions(m *spec.Swagger) *spec.Swagger {
 	return m
 }
 
+// renameConflictingDefinitions renames the definitions with the same name as a different
+// definition of the main spec, like the ones of two versions of a module, with the spec id
+// and updates their references so that the schemas of both modules are kept.
+func (c *Config) renameConflictingDefinitions(id string, m *spec.Swagger) (*spec.Swagger, error) {
+	renames := make(map[string]string)
+	for k, v := range m.Definitions {
+		existing, exists := c.spec.Definitions[k]
+		if !exists {
+			continue
+		}
+		equal, err := sameSchema(existing, v)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			renames[k] = c.uniqueDefinitionName(id, k)
+		}
+	}
+
+	return renameDefinitions(m, renames)
+}
+
+// uniqueDefinitionName returns a definition name prefixed by the spec id that is not used by the main spec.
+func (c *Config) uniqueDefinitionName(id, name string) string {
+	unique := fmt.Sprintf("%s.%s", id, name)
+	for i := 2; ; i++ {
+		if _, exists := c.spec.Definitions[unique]; !exists {
+			return unique
+		}
+		unique = fmt.Sprintf("%s%d.%s", id, i, name)
+	}
+}
+
+// renameDefinitions renames the definitions of a spec and updates all their references.
+func renameDefinitions(m *spec.Swagger, renames map[string]string) (*spec.Swagger, error) {
+	if len(renames) == 0 {
+		return m, nil
+	}
+
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	for name, newName := range renames {
+		data = bytes.ReplaceAll(data, refJSON(name), refJSON(newName))
+	}
+
+	var renamed spec.Swagger
+	if err := renamed.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	for name, newName := range renames {
+		renamed.Definitions[newName] = renamed.Definitions[name]
+		delete(renamed.Definitions, name)
+	}
+	return &renamed, nil
+}
+
+// refJSON returns the JSON string of a reference to a definition.
+func refJSON(name string) []byte {
+	ref, _ := json.Marshal(definitionsRefPrefix + name)
+	return ref
+}
+
+// sameSchema checks if two schemas have the same JSON definition.
+func sameSchema(a, b spec.Schema) (bool, error) {
+	dataA, err := a.MarshalJSON()
+	if err != nil {
+		return false, err
+	}
+	dataB, err := b.MarshalJSON()
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(dataA, dataB), nil
+}
+
 // mergeTags merge spec tags with main spec and erase the spec tag.
 func (c *Config) mergeTags(m *spec.Swagger) *spec.Swagger {
 	for _, v := range m.Tags {
@@ -98,23 +183,91 @@ func (c *Config) mergeTags(m *spec.Swagger) *spec.Swagger {
 }
 
 // Combine combines openapi specs into one and saves to out path.
+// The definitions with the same name as the ones of a previous spec are skipped.
 func (c *Config) Combine(out string) error {
-	sort.Slice(c.specs, func(a, b int) bool { return c.specs[a].ID < c.specs[b].ID })
+	combined, err := c.combine(false)
+	if err != nil {
+		return err
+	}
+	specJSON, err := combined.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return writeSpec(out, specJSON)
+}
 
-	errs := analysis.Mixin(c.spec, c.specs...)
-	if len(errs) > 0 {
-		return errors.Errorf("invalid mix specs: %s", strings.Join(errs, ", "))
+// CombineOpenAPI3 combines openapi specs into one, converts it
+// to an OpenAPI 3.1 spec and saves it to out path.
+// The definitions with the same name as different ones of a previous spec are renamed.
+func (c *Config) CombineOpenAPI3(out string) error {
+	combined, err := c.combine(true)
+	if err != nil {
+		return err
 	}
-	specJSON, err := c.spec.MarshalJSON()
+	doc, err := ToOpenAPI3(combined)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert the combined spec to OpenAPI 3.1")
+	}
+	specJSON, err := json.Marshal(doc)
 	if err != nil {
 		return err
 	}
+	return writeSpec(out, specJSON)
+}
+
+// combine mixes copies of the added specs into a copy of the main spec, so the specs
+// can be combined for each output. If renameConflicts is set the conflicting definitions
+// are renamed instead of being skipped.
+func (c *Config) combine(renameConflicts bool) (*spec.Swagger, error) {
+	combined := *c.spec
+	combined.Definitions = make(spec.Definitions)
+	combined.Tags = nil
+	cc := &Config{spec: &combined}
+
+	specs := make([]*spec.Swagger, 0, len(c.specs))
+	for _, added := range c.specs {
+		m, err := cloneSpec(added.spec)
+		if err != nil {
+			return nil, err
+		}
+		if renameConflicts {
+			m, err = cc.renameConflictingDefinitions(added.id, m)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to rename definitions of spec %s", added.id)
+			}
+		}
+		specs = append(specs, cc.mergeTags(cc.mergeDefinitions(m)))
+	}
+	sort.Slice(specs, func(a, b int) bool { return specs[a].ID < specs[b].ID })
+
+	errs := analysis.Mixin(cc.spec, specs...)
+	if len(errs) > 0 {
+		return nil, errors.Errorf("invalid mix specs: %s", strings.Join(errs, ", "))
+	}
+	return cc.spec, nil
+}
+
+// cloneSpec returns a deep copy of a spec.
+func cloneSpec(m *spec.Swagger) (*spec.Swagger, error) {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var clone spec.Swagger
+	if err := clone.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return &clone, nil
+}
+
+// writeSpec writes a spec to out path.
+func writeSpec(out string, specJSON []byte) error {
 	// ensure out dir exists.
 	outDir := filepath.Dir(out)
 	if err := os.MkdirAll(outDir, 0o766); err != nil {
 		return err
 	}
-	if err = os.WriteFile(out, specJSON, 0o600); err != nil {
+	if err := os.WriteFile(out, specJSON, 0o600); err != nil {
 		return errors.Wrapf(err, "failed to write combined spec to file %s", out)
 	}
 	return nil
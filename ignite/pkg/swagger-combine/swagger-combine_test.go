@@ -0,0 +1,203 @@
+package swaggercombine_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	swaggercombine "github.com/ignite/cli/v29/ignite/pkg/swagger-combine"
+)
+
+const (
+	bankSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "bank", "version": "v1"},
+  "paths": {
+    "/cosmos/bank/v1beta1/balances/{address}": {
+      "get": {
+        "operationId": "AllBalances",
+        "parameters": [
+          {"name": "address", "in": "path", "required": true, "type": "string"},
+          {"name": "pagination.key", "in": "query", "required": false, "type": "string", "format": "byte"},
+          {"name": "pagination.count_total", "in": "query", "required": false, "type": "boolean"},
+          {"name": "denoms", "in": "query", "required": false, "type": "array", "items": {"type": "string"}, "collectionFormat": "multi"}
+        ],
+        "responses": {
+          "200": {"description": "A successful response.", "schema": {"$ref": "#/definitions/Metadata"}},
+          "default": {"description": "An unexpected error response.", "schema": {"$ref": "#/definitions/google.protobuf.Any"}}
+        },
+        "tags": ["Query"]
+      }
+    }
+  },
+  "definitions": {
+    "Metadata": {
+      "type": "object",
+      "properties": {
+        "base": {"type": "string"},
+        "any": {"$ref": "#/definitions/google.protobuf.Any"}
+      }
+    },
+    "google.protobuf.Any": {
+      "type": "object",
+      "properties": {"@type": {"type": "string"}},
+      "additionalProperties": {}
+    }
+  }
+}`
+
+	nftSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "nft", "version": "v1"},
+  "paths": {
+    "/cosmos/nft/v1beta1/send": {
+      "post": {
+        "operationId": "Send",
+        "parameters": [
+          {"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/Metadata"}}
+        ],
+        "responses": {
+          "200": {"description": "A successful response.", "schema": {"type": "object", "x-nullable": true}}
+        },
+        "tags": ["Msg"]
+      }
+    }
+  },
+  "definitions": {
+    "Metadata": {
+      "type": "object",
+      "properties": {"uri": {"type": "string"}}
+    },
+    "google.protobuf.Any": {
+      "type": "object",
+      "properties": {"@type": {"type": "string"}},
+      "additionalProperties": {}
+    }
+  }
+}`
+)
+
+func TestCombine(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		conf = swaggercombine.New("HTTP API Console", "chain")
+	)
+
+	for _, s := range []struct{ id, content string }{
+		{"Bank", bankSpec},
+		{"Nft", nftSpec},
+	} {
+		path := filepath.Join(dir, s.id+".json")
+		require.NoError(t, os.WriteFile(path, []byte(s.content), 0o600))
+		require.NoError(t, conf.AddSpec(s.id, path, true))
+	}
+
+	var (
+		swaggerOut = filepath.Join(dir, "openapi.yml")
+		v3Out      = filepath.Join(dir, "openapi3.yml")
+	)
+	require.NoError(t, conf.Combine(swaggerOut))
+	require.NoError(t, conf.CombineOpenAPI3(v3Out))
+
+	// The Swagger 2.0 spec keeps the first definition with a given name
+	var swagger map[string]any
+	readJSON(t, swaggerOut, &swagger)
+	definitions := swagger["definitions"].(map[string]any)
+	require.Len(t, definitions, 2)
+	require.Contains(t, definitions, "google.protobuf.Any")
+	require.Equal(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"base": map[string]any{"type": "string"},
+			"any":  map[string]any{"$ref": "#/definitions/google.protobuf.Any"},
+		},
+	}, definitions["Metadata"])
+	swaggerSend := swagger["paths"].(map[string]any)["/cosmos/nft/v1beta1/send"].(map[string]any)["post"].(map[string]any)
+	require.Equal(t, map[string]any{"$ref": "#/definitions/Metadata"},
+		swaggerSend["parameters"].([]any)[0].(map[string]any)["schema"])
+
+	// The OpenAPI 3.1 spec renames the definitions with the same name when they are different
+
+	var doc map[string]any
+	readJSON(t, v3Out, &doc)
+	require.Equal(t, "3.1.0", doc["openapi"])
+	require.NotContains(t, doc, "definitions")
+
+	schemas := doc["components"].(map[string]any)["schemas"].(map[string]any)
+	require.Equal(t, map[string]any{
+		"type":                 "object",
+		"properties":           map[string]any{"@type": map[string]any{"type": "string"}},
+		"additionalProperties": map[string]any{},
+	}, schemas["google.protobuf.Any"])
+	require.Len(t, schemas, 3)
+	require.Contains(t, schemas, "Nft.Metadata")
+
+	paths := doc["paths"].(map[string]any)
+
+	// The query parameters are kept with their type in their schema
+	balances := paths["/cosmos/bank/v1beta1/balances/{address}"].(map[string]any)["get"].(map[string]any)
+	require.Equal(t, "BankAllBalances", balances["operationId"])
+	require.Equal(t, []any{
+		map[string]any{
+			"name":     "address",
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]any{"type": "string"},
+		},
+		map[string]any{
+			"name":   "pagination.key",
+			"in":     "query",
+			"schema": map[string]any{"type": "string", "format": "byte"},
+		},
+		map[string]any{
+			"name":   "pagination.count_total",
+			"in":     "query",
+			"schema": map[string]any{"type": "boolean"},
+		},
+		map[string]any{
+			"name":    "denoms",
+			"in":      "query",
+			"style":   "form",
+			"explode": true,
+			"schema":  map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+		},
+	}, balances["parameters"])
+
+	responses := balances["responses"].(map[string]any)
+	require.Equal(t, map[string]any{
+		"description": "An unexpected error response.",
+		"content": map[string]any{
+			"application/json": map[string]any{
+				"schema": map[string]any{"$ref": "#/components/schemas/google.protobuf.Any"},
+			},
+		},
+	}, responses["default"])
+
+	// The body parameters are request bodies
+	send := paths["/cosmos/nft/v1beta1/send"].(map[string]any)["post"].(map[string]any)
+	require.NotContains(t, send, "parameters")
+	require.Equal(t, map[string]any{
+		"required": true,
+		"content": map[string]any{
+			"application/json": map[string]any{
+				"schema": map[string]any{"$ref": "#/components/schemas/Nft.Metadata"},
+			},
+		},
+	}, send["requestBody"])
+
+	// The nullable types include the null type
+	sendResponse := send["responses"].(map[string]any)["200"].(map[string]any)
+	require.Equal(t, map[string]any{"type": []any{"object", "null"}},
+		sendResponse["content"].(map[string]any)["application/json"].(map[string]any)["schema"])
+}
+
+func readJSON(t *testing.T, path string, v any) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
@@ -0,0 +1,154 @@
+package xast
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strconv"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+type (
+	// literalOpts represent the options for composite literals.
+	literalOpts struct {
+		sliceValues  []literalValue
+		stringValues []literalValue
+	}
+
+	// LiteralOptions configures code generation.
+	LiteralOptions func(*literalOpts)
+
+	literalValue struct {
+		key  string
+		code string
+	}
+)
+
+// AppendLiteralSliceValue add a new element inside the slice literal of a key. For instances,
+// the literal have the element 'Args: []Arg{{Name: "a"}}' and we want to add
+// the '{Name: "b"}' the result will be 'Args: []Arg{{Name: "a"}, {Name: "b"}}'.
+func AppendLiteralSliceValue(key, code string) LiteralOptions {
+	return func(c *literalOpts) {
+		c.sliceValues = append(c.sliceValues, literalValue{
+			key:  key,
+			code: code,
+		})
+	}
+}
+
+// AppendLiteralStringValue append a suffix to the string value of a key. For instances,
+// the literal have the element 'Use: "create [a]"' and we want to add
+// the ' [b]' suffix the result will be 'Use: "create [a] [b]"'.
+func AppendLiteralStringValue(key, suffix string) LiteralOptions {
+	return func(c *literalOpts) {
+		c.stringValues = append(c.stringValues, literalValue{
+			key:  key,
+			code: suffix,
+		})
+	}
+}
+
+func newLiteralOptions() literalOpts {
+	return literalOpts{
+		sliceValues:  make([]literalValue, 0),
+		stringValues: make([]literalValue, 0),
+	}
+}
+
+// ModifyLiteral modifies the composite literals of the provided Go source code that
+// contain a key with the given string value, e.g. 'RpcMethod: "CreatePost"'.
+func ModifyLiteral(fileContent, key, value string, options ...LiteralOptions) (string, error) {
+	// Apply literal options.
+	opts := newLiteralOptions()
+	for _, o := range options {
+		o(&opts)
+	}
+
+	fileSet := token.NewFileSet()
+
+	// Parse the Go source code content.
+	f, err := parser.ParseFile(fileSet, "", fileContent, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	// Locate and modify the composite literals.
+	var (
+		found      bool
+		errInspect error
+	)
+	ast.Inspect(f, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok || !hasLiteralValue(lit, key, value) {
+			return true
+		}
+
+		for _, v := range opts.sliceValues {
+			slice, ok := literalValueOf(lit, v.key).(*ast.CompositeLit)
+			if !ok {
+				errInspect = errors.Errorf("slice literal %q not found in %s literal", v.key, value)
+				return false
+			}
+			slice.Elts = append(slice.Elts, ast.NewIdent(v.code))
+		}
+
+		for _, v := range opts.stringValues {
+			basicLit, ok := literalValueOf(lit, v.key).(*ast.BasicLit)
+			if !ok || basicLit.Kind != token.STRING {
+				errInspect = errors.Errorf("string literal %q not found in %s literal", v.key, value)
+				return false
+			}
+			s, err := strconv.Unquote(basicLit.Value)
+			if err != nil {
+				errInspect = err
+				return false
+			}
+			basicLit.Value = strconv.Quote(s + v.code)
+		}
+
+		found = true
+		return false
+	})
+	if errInspect != nil {
+		return "", errInspect
+	}
+	if !found {
+		return "", errors.Errorf("literal with %s %q not found in file content", key, value)
+	}
+
+	// Format the modified AST.
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fileSet, f); err != nil {
+		return "", err
+	}
+
+	// Return the modified content.
+	return buf.String(), nil
+}
+
+// literalValueOf returns the value of the key inside the composite literal.
+func literalValueOf(lit *ast.CompositeLit, key string) ast.Expr {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if ident, ok := kv.Key.(*ast.Ident); ok && ident.Name == key {
+			return kv.Value
+		}
+	}
+	return nil
+}
+
+// hasLiteralValue returns true if the composite literal has the key with the string value.
+func hasLiteralValue(lit *ast.CompositeLit, key, value string) bool {
+	basicLit, ok := literalValueOf(lit, key).(*ast.BasicLit)
+	if !ok || basicLit.Kind != token.STRING {
+		return false
+	}
+	s, err := strconv.Unquote(basicLit.Value)
+	return err == nil && s == value
+}
@@ -0,0 +1,115 @@
+package xast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+func TestModifyLiteral(t *testing.T) {
+	autocli := `package module
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreatePost",
+					Use:            "create-post [title]",
+					Short:          "Create post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
+`
+	type args struct {
+		fileContent string
+		key         string
+		value       string
+		options     []LiteralOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+		err  error
+	}{
+		{
+			name: "Append slice and string values",
+			args: args{
+				fileContent: autocli,
+				key:         "RpcMethod",
+				value:       "CreatePost",
+				options: []LiteralOptions{
+					AppendLiteralSliceValue("PositionalArgs", `{ProtoField: "body"}`),
+					AppendLiteralStringValue("Use", " [body]"),
+				},
+			},
+			want: `package module
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreatePost",
+					Use:            "create-post [title] [body]",
+					Short:          "Create post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}, {ProtoField: "body"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
+`,
+		},
+		{
+			name: "Literal not found",
+			args: args{
+				fileContent: autocli,
+				key:         "RpcMethod",
+				value:       "UpdatePost",
+				options:     []LiteralOptions{AppendLiteralStringValue("Use", " [body]")},
+			},
+			err: errors.New(`literal with RpcMethod "UpdatePost" not found in file content`),
+		},
+		{
+			name: "Slice value not found",
+			args: args{
+				fileContent: autocli,
+				key:         "RpcMethod",
+				value:       "CreatePost",
+				options:     []LiteralOptions{AppendLiteralSliceValue("Alias", `"new-post"`)},
+			},
+			err: errors.New(`slice literal "Alias" not found in CreatePost literal`),
+		},
+		{
+			name: "String value not found",
+			args: args{
+				fileContent: autocli,
+				key:         "RpcMethod",
+				value:       "CreatePost",
+				options:     []LiteralOptions{AppendLiteralStringValue("Long", " [body]")},
+			},
+			err: errors.New(`string literal "Long" not found in CreatePost literal`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ModifyLiteral(tt.args.fileContent, tt.args.key, tt.args.value, tt.args.options...)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.err.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,638 @@
+package xast
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// removeOpts represent the options for the references removal.
+	removeOpts struct {
+		idents      map[string]struct{}
+		fieldValues map[string]struct{}
+	}
+
+	// RemoveOptions configures the references removal.
+	RemoveOptions func(*removeOpts)
+)
+
+// WithIdents removes the code that refers to one of the given identifiers.
+func WithIdents(names ...string) RemoveOptions {
+	return func(c *removeOpts) {
+		for _, name := range names {
+			c.idents[name] = struct{}{}
+		}
+	}
+}
+
+// WithFieldValues removes the composite literals having a field set to one of the given
+// string values, e.g. `{RpcMethod: "ListPost"}` or `{desc: "duplicated post"}`.
+func WithFieldValues(values ...string) RemoveOptions {
+	return func(c *removeOpts) {
+		for _, value := range values {
+			c.fieldValues[value] = struct{}{}
+		}
+	}
+}
+
+func newRemoveOptions() removeOpts {
+	return removeOpts{
+		idents:      make(map[string]struct{}),
+		fieldValues: make(map[string]struct{}),
+	}
+}
+
+// RemoveReferences removes from the provided Go source code content the code that refers
+// to the given identifiers or field values. It is the counterpart of the code insertions
+// made by the scaffolding templates and removes:
+//   - the function, type, variable and constant declarations using one of the identifiers as name;
+//   - the struct fields using one of the identifiers as name;
+//   - the composite literal elements using one of the identifiers as key, referencing one of the
+//     identifiers or having a field set to one of the field values;
+//   - the statements referencing one of the identifiers, including the `if err != nil` check
+//     that follows them when they assign an error;
+//   - the local variables and the imports that are not used anymore because of the removal.
+//
+// The content is returned unchanged when nothing refers to the identifiers or field values.
+func RemoveReferences(fileContent string, options ...RemoveOptions) (modifiedContent string, err error) {
+	// apply remove options.
+	opts := newRemoveOptions()
+	for _, o := range options {
+		o(&opts)
+	}
+
+	fileSet := token.NewFileSet()
+
+	// Parse the Go source code content.
+	f, err := parser.ParseFile(fileSet, "", fileContent, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	r := &remover{
+		opts:    opts,
+		fileSet: fileSet,
+		locals:  make(map[ast.Node]map[string]int),
+		emptied: make(map[*ast.GenDecl]bool),
+	}
+	imports := importUsages(f)
+
+	// Walk the tree in post-order, so the inner nodes are cleaned up before their parents.
+	stack := make([]ast.Node, 0)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n != nil {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				if n.Body != nil {
+					r.locals[n] = identUsages(n.Body)
+				}
+			case *ast.FuncLit:
+				r.locals[n] = identUsages(n.Body)
+			}
+			stack = append(stack, n)
+			return true
+		}
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		r.clean(node)
+		return true
+	})
+	if len(r.removed) == 0 {
+		return fileContent, nil
+	}
+
+	// Remove the imports that are not used anymore.
+	r.removeUnusedImports(f, imports)
+
+	// Remove the code of the removed nodes from the content, so the rest of the
+	// file keeps its original layout and comments.
+	content, err := format.Source(r.removeRanges(fileContent))
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// remover removes the references from a Go file AST.
+type remover struct {
+	opts    removeOpts
+	fileSet *token.FileSet
+	// locals holds the identifier usages of the functions before any removal.
+	locals map[ast.Node]map[string]int
+	// emptied holds the declarations that have no spec left after the removal.
+	emptied map[*ast.GenDecl]bool
+	// removed holds the position ranges of the removed nodes.
+	removed [][2]token.Pos
+}
+
+// clean removes the references from the direct children of the node.
+func (r *remover) clean(n ast.Node) {
+	switch n := n.(type) {
+	case *ast.File:
+		decls := make([]ast.Decl, 0, len(n.Decls))
+		for _, decl := range n.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if r.isIdent(decl.Name) {
+					r.remove(decl)
+					continue
+				}
+			case *ast.GenDecl:
+				if r.emptied[decl] {
+					r.remove(decl)
+					continue
+				}
+			}
+			decls = append(decls, decl)
+		}
+		n.Decls = decls
+	case *ast.GenDecl:
+		if n.Tok == token.IMPORT {
+			return
+		}
+		specs := make([]ast.Spec, 0, len(n.Specs))
+		for _, spec := range n.Specs {
+			if r.matchSpec(spec) {
+				r.remove(spec)
+				continue
+			}
+			specs = append(specs, spec)
+		}
+		if len(specs) == 0 && len(n.Specs) > 0 {
+			r.emptied[n] = true
+		}
+		n.Specs = specs
+	case *ast.StructType:
+		fields := make([]*ast.Field, 0, len(n.Fields.List))
+		for _, field := range n.Fields.List {
+			names := make([]*ast.Ident, 0, len(field.Names))
+			for _, name := range field.Names {
+				if !r.isIdent(name) {
+					names = append(names, name)
+				}
+			}
+			if len(field.Names) > 0 && len(names) == 0 {
+				r.remove(field)
+				continue
+			}
+			field.Names = names
+			fields = append(fields, field)
+		}
+		n.Fields.List = fields
+	case *ast.CompositeLit:
+		elts := make([]ast.Expr, 0, len(n.Elts))
+		for _, elt := range n.Elts {
+			if r.matchElement(elt) {
+				r.remove(elt)
+				continue
+			}
+			elts = append(elts, elt)
+		}
+		n.Elts = elts
+	case *ast.BlockStmt:
+		n.List = r.cleanStmts(n.List)
+	case *ast.CaseClause:
+		n.Body = r.cleanStmts(n.Body)
+	case *ast.CommClause:
+		n.Body = r.cleanStmts(n.Body)
+	case *ast.FuncDecl:
+		if n.Body != nil {
+			r.removeUnusedLocals(n.Body, r.locals[n])
+		}
+	case *ast.FuncLit:
+		r.removeUnusedLocals(n.Body, r.locals[n])
+	}
+}
+
+// cleanStmts removes the statements referencing the identifiers.
+func (r *remover) cleanStmts(list []ast.Stmt) []ast.Stmt {
+	var (
+		stmts        = make([]ast.Stmt, 0, len(list))
+		dropErrCheck bool
+	)
+	for _, stmt := range list {
+		if dropErrCheck && isErrCheck(stmt) {
+			r.remove(stmt)
+			dropErrCheck = false
+			continue
+		}
+		dropErrCheck = false
+
+		if r.references(stmt) || r.isEmptiedDecl(stmt) {
+			r.remove(stmt)
+			dropErrCheck = assignsErr(stmt)
+			continue
+		}
+		stmts = append(stmts, stmt)
+	}
+	return stmts
+}
+
+// removeUnusedLocals removes the local variable declarations that were used before
+// the removal and that are not used anymore.
+func (r *remover) removeUnusedLocals(body *ast.BlockStmt, before map[string]int) {
+	for {
+		var (
+			after   = identUsages(body)
+			removed bool
+		)
+		isUnused := func(names []*ast.Ident) bool {
+			unused := false
+			for _, name := range names {
+				if name.Name == "_" {
+					continue
+				}
+				// the declaration is the only usage left.
+				if after[name.Name] > 1 || before[name.Name] <= 1 {
+					return false
+				}
+				unused = true
+			}
+			return unused
+		}
+
+		ast.Inspect(body, func(n ast.Node) bool {
+			var list *[]ast.Stmt
+			switch n := n.(type) {
+			case *ast.BlockStmt:
+				list = &n.List
+			case *ast.CaseClause:
+				list = &n.Body
+			case *ast.CommClause:
+				list = &n.Body
+			default:
+				return true
+			}
+
+			stmts := make([]ast.Stmt, 0, len(*list))
+			for _, stmt := range *list {
+				if names := definedIdents(stmt); len(names) > 0 && isUnused(names) {
+					r.remove(stmt)
+					removed = true
+					continue
+				}
+				stmts = append(stmts, stmt)
+			}
+			*list = stmts
+			return true
+		})
+		if !removed {
+			return
+		}
+	}
+}
+
+// removeUnusedImports removes the imports that were used before the removal and that
+// are not used anymore.
+func (r *remover) removeUnusedImports(f *ast.File, before map[string]int) {
+	after := importUsages(f)
+	decls := make([]ast.Decl, 0, len(f.Decls))
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			decls = append(decls, decl)
+			continue
+		}
+
+		specs := make([]ast.Spec, 0, len(genDecl.Specs))
+		for _, spec := range genDecl.Specs {
+			name := importName(spec.(*ast.ImportSpec))
+			if before[name] > 0 && after[name] == 0 {
+				r.remove(spec)
+				continue
+			}
+			specs = append(specs, spec)
+		}
+		if len(specs) == 0 {
+			r.remove(genDecl)
+			continue
+		}
+		genDecl.Specs = specs
+		decls = append(decls, genDecl)
+	}
+	f.Decls = decls
+
+}
+
+// matchSpec returns true if the spec declares or refers to one of the identifiers.
+func (r *remover) matchSpec(spec ast.Spec) bool {
+	switch spec := spec.(type) {
+	case *ast.ValueSpec:
+		for _, name := range spec.Names {
+			if r.isIdent(name) {
+				return true
+			}
+		}
+		for _, value := range spec.Values {
+			if r.references(value) {
+				return true
+			}
+		}
+	case *ast.TypeSpec:
+		return r.isIdent(spec.Name)
+	}
+	return false
+}
+
+// matchElement returns true if the composite literal element must be removed.
+func (r *remover) matchElement(elt ast.Expr) bool {
+	if kv, ok := elt.(*ast.KeyValueExpr); ok {
+		if key, ok := kv.Key.(*ast.Ident); ok && r.isIdent(key) {
+			return true
+		}
+	}
+
+	value := elt
+	if unary, ok := value.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		value = unary.X
+	}
+	if lit, ok := value.(*ast.CompositeLit); ok {
+		for _, e := range lit.Elts {
+			kv, ok := e.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			basicLit, ok := kv.Value.(*ast.BasicLit)
+			if !ok || basicLit.Kind != token.STRING {
+				continue
+			}
+			if s, err := strconv.Unquote(basicLit.Value); err == nil {
+				if _, ok := r.opts.fieldValues[s]; ok {
+					return true
+				}
+			}
+		}
+	}
+
+	return r.references(elt)
+}
+
+// references returns true if the node refers to one of the identifiers.
+func (r *remover) references(n ast.Node) (found bool) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && r.isIdent(ident) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// isEmptiedDecl returns true if the statement is a declaration emptied by the removal.
+func (r *remover) isEmptiedDecl(stmt ast.Stmt) bool {
+	declStmt, ok := stmt.(*ast.DeclStmt)
+	if !ok {
+		return false
+	}
+	genDecl, ok := declStmt.Decl.(*ast.GenDecl)
+	return ok && r.emptied[genDecl]
+}
+
+func (r *remover) isIdent(ident *ast.Ident) bool {
+	_, ok := r.opts.idents[ident.Name]
+	return ok
+}
+
+// remove records the position range of a removed node, including its doc comment.
+func (r *remover) remove(n ast.Node) {
+	start := n.Pos()
+	switch n := n.(type) {
+	case *ast.Field:
+		if n.Doc != nil {
+			start = n.Doc.Pos()
+		}
+	case *ast.ValueSpec:
+		if n.Doc != nil {
+			start = n.Doc.Pos()
+		}
+	case *ast.TypeSpec:
+		if n.Doc != nil {
+			start = n.Doc.Pos()
+		}
+	case *ast.GenDecl:
+		if n.Doc != nil {
+			start = n.Doc.Pos()
+		}
+	case *ast.FuncDecl:
+		if n.Doc != nil {
+			start = n.Doc.Pos()
+		}
+	}
+	r.removed = append(r.removed, [2]token.Pos{start, n.End()})
+}
+
+// removeRanges returns the content without the code of the removed nodes. The lines
+// only containing removed code are removed, as well as the blank lines left at the
+// beginning or the end of a block.
+func (r *remover) removeRanges(content string) []byte {
+	type span struct {
+		start, end int
+	}
+
+	spans := make([]span, 0, len(r.removed))
+	for _, rng := range r.removed {
+		start, end := expandRange(content, r.fileSet.Position(rng[0]).Offset, r.fileSet.Position(rng[1]).Offset)
+		spans = append(spans, span{start, end})
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	// merge the overlapping spans and the removed lines only separated by blank lines.
+	isLineStart := func(i int) bool { return i == 0 || content[i-1] == '\n' }
+	merged := make([]span, 0, len(spans))
+	for _, s := range spans {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			if s.start <= last.end ||
+				(isLineStart(last.end) && isLineStart(s.start) && strings.TrimSpace(content[last.end:s.start]) == "") {
+				last.end = max(last.end, s.end)
+				continue
+			}
+		}
+		merged = append(merged, s)
+	}
+
+	var (
+		out = make([]byte, 0, len(content))
+		pos int
+	)
+	for _, s := range merged {
+		out = append(out, content[pos:s.start]...)
+		pos = s.end
+
+		// only clean up the blank lines around the removed lines.
+		if !bytes.HasSuffix(out, []byte("\n")) {
+			continue
+		}
+		prevLine := strings.TrimSpace(lastLine(string(out[:len(out)-1])))
+		if strings.HasSuffix(prevLine, "{") || strings.HasSuffix(prevLine, "(") {
+			for pos < len(content) {
+				i := strings.IndexByte(content[pos:], '\n')
+				if i < 0 || strings.TrimSpace(content[pos:pos+i]) != "" {
+					break
+				}
+				pos += i + 1
+			}
+		}
+		nextLine := strings.TrimSpace(content[pos:])
+		if strings.HasPrefix(nextLine, "}") || strings.HasPrefix(nextLine, ")") {
+			for len(out) > 1 && strings.TrimSpace(lastLine(string(out[:len(out)-1]))) == "" {
+				out = out[:len(out)-1-len(lastLine(string(out[:len(out)-1])))]
+			}
+		}
+	}
+	return append(out, content[pos:]...)
+}
+
+// expandRange expands the range of a removed node to its separator and to the whole
+// lines when the node is the only code of its lines.
+func expandRange(content string, start, end int) (int, int) {
+	// consume the comma following the node.
+	i := end
+	for i < len(content) && (content[i] == ' ' || content[i] == '\t') {
+		i++
+	}
+	hasComma := i < len(content) && content[i] == ','
+	if hasComma {
+		end = i + 1
+	}
+
+	lineStart := strings.LastIndexByte(content[:start], '\n') + 1
+	lineEnd := strings.IndexByte(content[end:], '\n')
+	if lineEnd < 0 {
+		lineEnd = len(content)
+	} else {
+		lineEnd += end
+	}
+	rest := strings.TrimSpace(content[end:lineEnd])
+	if strings.TrimSpace(content[lineStart:start]) == "" && (rest == "" || strings.HasPrefix(rest, "//")) {
+		return lineStart, min(lineEnd+1, len(content))
+	}
+
+	// consume the comma preceding the node when it is the last one.
+	if !hasComma {
+		j := start
+		for j > 0 && (content[j-1] == ' ' || content[j-1] == '\t') {
+			j--
+		}
+		if j > 0 && content[j-1] == ',' {
+			start = j - 1
+		}
+	}
+	return start, end
+}
+
+// lastLine returns the last line of the content.
+func lastLine(content string) string {
+	return content[strings.LastIndexByte(content, '\n')+1:]
+}
+
+// isErrCheck returns true if the statement is an error check like `if err != nil { ... }`.
+func isErrCheck(stmt ast.Stmt) bool {
+	ifStmt, ok := stmt.(*ast.IfStmt)
+	if !ok || ifStmt.Init != nil || ifStmt.Else != nil {
+		return false
+	}
+	found := false
+	ast.Inspect(ifStmt.Cond, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Name == "err" {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// assignsErr returns true if the statement assigns a variable named err.
+func assignsErr(stmt ast.Stmt) bool {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok {
+		return false
+	}
+	for _, lhs := range assign.Lhs {
+		if ident, ok := lhs.(*ast.Ident); ok && ident.Name == "err" {
+			return true
+		}
+	}
+	return false
+}
+
+// definedIdents returns the identifiers declared by a local variable declaration.
+func definedIdents(stmt ast.Stmt) []*ast.Ident {
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		if stmt.Tok != token.DEFINE {
+			return nil
+		}
+		names := make([]*ast.Ident, 0, len(stmt.Lhs))
+		for _, lhs := range stmt.Lhs {
+			ident, ok := lhs.(*ast.Ident)
+			if !ok {
+				return nil
+			}
+			names = append(names, ident)
+		}
+		return names
+	case *ast.DeclStmt:
+		genDecl, ok := stmt.Decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			return nil
+		}
+		names := make([]*ast.Ident, 0)
+		for _, spec := range genDecl.Specs {
+			names = append(names, spec.(*ast.ValueSpec).Names...)
+		}
+		return names
+	}
+	return nil
+}
+
+// identUsages returns the number of occurrences of each identifier in the node.
+func identUsages(n ast.Node) map[string]int {
+	usages := make(map[string]int)
+	ast.Inspect(n, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			usages[ident.Name]++
+		}
+		return true
+	})
+	return usages
+}
+
+// importUsages returns the number of selector expressions using each package name of the file.
+func importUsages(f *ast.File) map[string]int {
+	usages := make(map[string]int)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				usages[ident.Name]++
+			}
+		}
+		return true
+	})
+	return usages
+}
+
+// importName returns the name used to refer to an import in the code.
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	importPath, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return ""
+	}
+	name := path.Base(importPath)
+	// skip the major version suffix of the import path, e.g. `github.com/foo/bar/v2`.
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = path.Base(path.Dir(importPath))
+	}
+	return name
+}
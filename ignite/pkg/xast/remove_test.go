@@ -0,0 +1,286 @@
+package xast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+func TestRemoveReferences(t *testing.T) {
+	type args struct {
+		fileContent string
+		options     []RemoveOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+		err  error
+	}{
+		{
+			name: "Remove struct fields and keyed elements",
+			args: args{
+				fileContent: `package keeper
+
+type Keeper struct {
+	Params  collections.Item[types.Params]
+	PostSeq collections.Sequence
+	// Post is the post map.
+	Post collections.Map[uint64, types.Post]
+}
+
+func NewKeeper(sb *collections.SchemaBuilder) Keeper {
+	return Keeper{
+		Params: collections.NewItem(sb, types.ParamsKey), PostSeq: collections.NewSequence(sb, types.PostCountKey),
+		Post: collections.NewMap(sb, types.PostKey),
+	}
+}
+`,
+				options: []RemoveOptions{WithIdents("Post", "PostSeq")},
+			},
+			want: `package keeper
+
+type Keeper struct {
+	Params collections.Item[types.Params]
+}
+
+func NewKeeper(sb *collections.SchemaBuilder) Keeper {
+	return Keeper{
+		Params: collections.NewItem(sb, types.ParamsKey),
+	}
+}
+`,
+		},
+		{
+			name: "Remove statements with their error check",
+			args: args{
+				fileContent: `package keeper
+
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var err error
+
+	genesis := types.DefaultGenesis()
+	genesis.Params, err = k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Post.Walk(ctx, nil, func(key uint64, elem types.Post) (bool, error) {
+		genesis.PostList = append(genesis.PostList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.PostCount, err = k.PostSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+`,
+				options: []RemoveOptions{WithIdents("Post", "PostSeq", "PostList", "PostCount")},
+			},
+			want: `package keeper
+
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var err error
+
+	genesis := types.DefaultGenesis()
+	genesis.Params, err = k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+`,
+		},
+		{
+			name: "Remove unused local variables and imports",
+			args: args{
+				fileContent: `package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (gs GenesisState) Validate() error {
+	postIdMap := make(map[uint64]bool)
+	postCount := gs.GetPostCount()
+	for _, elem := range gs.PostList {
+		if _, ok := postIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for post")
+		}
+		if elem.Id >= postCount {
+			return fmt.Errorf("post id should be lower or equal than the last id")
+		}
+		postIdMap[elem.Id] = true
+	}
+
+	return gs.Params.Validate(strings.ToLower(gs.Name))
+}
+`,
+				options: []RemoveOptions{WithIdents("PostList")},
+			},
+			want: `package types
+
+import (
+	"strings"
+)
+
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate(strings.ToLower(gs.Name))
+}
+`,
+		},
+		{
+			name: "Remove declarations",
+			args: args{
+				fileContent: `package types
+
+var ParamsKey = collections.NewPrefix("p_mars")
+
+var (
+	PostKey      = collections.NewPrefix("post/value/")
+	PostCountKey = collections.NewPrefix("post/count/")
+)
+
+const (
+	// this line is used by starport scaffolding # simapp/module/const
+)
+
+// NewMsgCreatePost creates a new MsgCreatePost.
+func NewMsgCreatePost() *MsgCreatePost {
+	return &MsgCreatePost{}
+}
+`,
+				options: []RemoveOptions{WithIdents("PostKey", "PostCountKey", "NewMsgCreatePost")},
+			},
+			want: `package types
+
+var ParamsKey = collections.NewPrefix("p_mars")
+
+const (
+// this line is used by starport scaffolding # simapp/module/const
+)
+`,
+		},
+		{
+			name: "Remove composite literals by field value",
+			args: args{
+				fileContent: `package module
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+				},
+				{
+					RpcMethod: "ListPost",
+					Use:       "list-post",
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+	}
+}
+`,
+				options: []RemoveOptions{WithFieldValues("ListPost")},
+			},
+			want: `package module
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+	}
+}
+`,
+		},
+		{
+			name: "Remove switch cases",
+			args: args{
+				fileContent: `package module
+
+func (im IBCModule) OnTimeoutPacket(packet modulePacketData) error {
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.MarsPacketData_SharePostPacket:
+		return im.keeper.OnTimeoutSharePostPacket(packet)
+	// this line is used by starport scaffolding # ibc/packet/module/timeout
+	default:
+		return errors.New("unrecognized packet type")
+	}
+}
+`,
+				options: []RemoveOptions{WithIdents("MarsPacketData_SharePostPacket")},
+			},
+			want: `package module
+
+func (im IBCModule) OnTimeoutPacket(packet modulePacketData) error {
+	switch packet := modulePacketData.Packet.(type) {
+	// this line is used by starport scaffolding # ibc/packet/module/timeout
+	default:
+		return errors.New("unrecognized packet type")
+	}
+}
+`,
+		},
+		{
+			name: "Nothing to remove",
+			args: args{
+				fileContent: `package main
+
+func main() {
+	foo  := 1
+	_ = foo
+}
+`,
+				options: []RemoveOptions{WithIdents("bar")},
+			},
+			want: `package main
+
+func main() {
+	foo  := 1
+	_ = foo
+}
+`,
+		},
+		{
+			name: "Invalid Go code",
+			args: args{
+				fileContent: `package main
+
+type MyStruct`,
+				options: []RemoveOptions{WithIdents("MyStruct")},
+			},
+			err: errors.New("3:14: expected type, found newline"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RemoveReferences(tt.args.fileContent, tt.args.options...)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.err.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package xgenny
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const devNull = "/dev/null"
+
+// UnifiedDiff returns the unified diff between the origin and the modified content of a file.
+// An empty string is returned when both contents are equal.
+func UnifiedDiff(name, origin, modified string, created bool) string {
+	from := "a/" + name
+	if created {
+		from = devNull
+	}
+	return unifiedDiff(name, from, "b/"+name, origin, modified)
+}
+
+func unifiedDiff(name, from, to, origin, modified string) string {
+	edits := myers.ComputeEdits(span.URIFromPath(name), origin, modified)
+	return fmt.Sprint(gotextdiff.ToUnified(from, to, origin, edits))
+}
+
+// DiffFiles returns the unified diff of the files listed in the source modification
+// between the origin and the modified root paths. The files of the source modification
+// must be located in one of the two root paths, the deleted files in the origin one.
+func DiffFiles(originRoot, modifiedRoot string, sm SourceModification) (string, error) {
+	type file struct {
+		name             string
+		created, deleted bool
+	}
+
+	files := make([]file, 0)
+	for _, name := range sm.ModifiedFiles() {
+		files = append(files, file{name: name})
+	}
+	for _, name := range sm.CreatedFiles() {
+		files = append(files, file{name: name, created: true})
+	}
+	for _, name := range sm.DeletedFiles() {
+		files = append(files, file{name: name, deleted: true})
+	}
+
+	diffs := make(map[string]string)
+	for _, f := range files {
+		relPath, err := relativeTo(f.name, originRoot, modifiedRoot)
+		if err != nil {
+			return "", err
+		}
+
+		var origin, modified []byte
+		if !f.created {
+			origin, err = os.ReadFile(filepath.Join(originRoot, relPath))
+			if err != nil {
+				return "", err
+			}
+		}
+		if !f.deleted {
+			modified, err = os.ReadFile(filepath.Join(modifiedRoot, relPath))
+			if err != nil {
+				return "", err
+			}
+		}
+
+		relPath = filepath.ToSlash(relPath)
+		if f.deleted {
+			diffs[relPath] = unifiedDiff(relPath, "a/"+relPath, devNull, string(origin), "")
+			continue
+		}
+		diffs[relPath] = UnifiedDiff(relPath, string(origin), string(modified), f.created)
+	}
+
+	names := make([]string, 0, len(diffs))
+	for name := range diffs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(diffs[name])
+	}
+	return b.String(), nil
+}
+
+// relativeTo returns the path relative to the first root that contains it.
+func relativeTo(path string, roots ...string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return path, nil
+	}
+	for _, root := range roots {
+		relPath, err := filepath.Rel(root, path)
+		if err != nil {
+			return "", err
+		}
+		if relPath != ".." && !strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			return relPath, nil
+		}
+	}
+	return "", errors.Errorf("file %s is not located in %s", path, strings.Join(roots, " or "))
+}
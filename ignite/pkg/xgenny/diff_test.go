@@ -0,0 +1,53 @@
+package xgenny_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	require.Empty(t, xgenny.UnifiedDiff("foo.go", "foo\n", "foo\n", false))
+	require.Equal(t,
+		"--- a/foo.go\n+++ b/foo.go\n@@ -1 +1,2 @@\n foo\n+bar\n",
+		xgenny.UnifiedDiff("foo.go", "foo\n", "foo\nbar\n", false),
+	)
+	require.Equal(t,
+		"--- /dev/null\n+++ b/bar.go\n@@ -1 +1 @@\n+bar\n",
+		xgenny.UnifiedDiff("bar.go", "", "bar\n", true),
+	)
+}
+
+func TestDiffFiles(t *testing.T) {
+	var (
+		origin   = t.TempDir()
+		modified = t.TempDir()
+	)
+	require.NoError(t, os.WriteFile(filepath.Join(origin, "foo.go"), []byte("foo\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(modified, "foo.go"), []byte("foo\nbar\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(modified, "x"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(modified, "x", "bar.go"), []byte("bar\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(origin, "baz.go"), []byte("baz\n"), 0o644))
+
+	sm := xgenny.NewSourceModification()
+	sm.AppendModifiedFiles(filepath.Join(origin, "foo.go"))
+	sm.AppendCreatedFiles(filepath.Join(modified, "x", "bar.go"))
+	sm.AppendDeletedFiles(filepath.Join(origin, "baz.go"))
+
+	diff, err := xgenny.DiffFiles(origin, modified, sm)
+	require.NoError(t, err)
+	require.Equal(t,
+		"--- a/baz.go\n+++ /dev/null\n@@ -1 +1 @@\n-baz\n"+
+			"--- a/foo.go\n+++ b/foo.go\n@@ -1 +1,2 @@\n foo\n+bar\n"+
+			"--- /dev/null\n+++ b/x/bar.go\n@@ -1 +1 @@\n+bar\n",
+		diff,
+	)
+
+	sm.AppendCreatedFiles("/unknown/baz.go")
+	_, err = xgenny.DiffFiles(origin, modified, sm)
+	require.Error(t, err)
+}
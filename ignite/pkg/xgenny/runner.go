@@ -18,6 +18,7 @@ type Runner struct {
 	ctx     context.Context
 	tracer  *placeholder.Tracer
 	results []genny.File
+	deleted []string
 	tmpPath string
 }
 
@@ -45,9 +46,8 @@ func (r *Runner) Tracer() *placeholder.Tracer {
 	return r.tracer
 }
 
-// ApplyModifications copy all modifications from the temporary folder to the target path.
-func (r *Runner) ApplyModifications() (SourceModification, error) {
-	// fetch the source modification
+// sourceModification returns the source modification of the staged files.
+func (r *Runner) sourceModification() (SourceModification, error) {
 	sm := NewSourceModification()
 	for _, file := range r.results {
 		fileName := file.Name()
@@ -61,22 +61,74 @@ func (r *Runner) ApplyModifications() (SourceModification, error) {
 			sm.AppendModifiedFiles(fileName) // the file has been modified by the runner
 		}
 	}
-	r.results = make([]genny.File, 0)
+	for _, fileName := range r.deleted {
+		if _, err := os.Stat(fileName); err == nil {
+			sm.AppendDeletedFiles(fileName)
+		}
+	}
+	return sm, nil
+}
 
-	if _, err := os.Stat(r.tmpPath); os.IsNotExist(err) {
-		return sm, nil
+// Delete stages the deletion of the given files. The files are deleted from the target path
+// when the modifications are applied, relative paths are relative to the target path.
+func (r *Runner) Delete(paths ...string) {
+	for _, path := range paths {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(r.Root, path)
+		}
+		r.deleted = append(r.deleted, path)
 	}
+}
 
-	// Create the target path and copy the content from the temporary folder.
-	if err := os.MkdirAll(r.Root, os.ModePerm); err != nil {
-		return sm, nil
+// PreviewModifications returns the source modification and the unified diff of all
+// modifications from the temporary folder, then discards them without touching the target path.
+func (r *Runner) PreviewModifications() (SourceModification, string, error) {
+	sm, err := r.sourceModification()
+	if err != nil {
+		return sm, "", err
 	}
-	err := xos.CopyFolder(r.tmpPath, r.Root)
+	r.results = make([]genny.File, 0)
+	r.deleted = nil
+
+	diff, err := DiffFiles(r.Root, r.tmpPath, sm)
 	if err != nil {
-		return sm, nil
+		return sm, "", err
+	}
+
+	return sm, diff, os.RemoveAll(r.tmpPath)
+}
+
+// ApplyModifications copy all modifications from the temporary folder to the target path.
+func (r *Runner) ApplyModifications() (SourceModification, error) {
+	// fetch the source modification
+	sm, err := r.sourceModification()
+	if err != nil {
+		return sm, err
+	}
+	r.results = make([]genny.File, 0)
+	r.deleted = nil
+
+	if _, err := os.Stat(r.tmpPath); err == nil {
+		// Create the target path and copy the content from the temporary folder.
+		if err := os.MkdirAll(r.Root, os.ModePerm); err != nil {
+			return sm, nil
+		}
+		if err := xos.CopyFolder(r.tmpPath, r.Root); err != nil {
+			return sm, nil
+		}
+		if err := os.RemoveAll(r.tmpPath); err != nil {
+			return sm, err
+		}
+	}
+
+	// Delete the staged files from the target path.
+	for _, fileName := range sm.DeletedFiles() {
+		if err := os.Remove(fileName); err != nil {
+			return sm, err
+		}
 	}
 
-	return sm, os.RemoveAll(r.tmpPath)
+	return sm, nil
 }
 
 // RunAndApply run the generators and apply the modifications to the target path.
@@ -11,21 +11,24 @@ import (
 var (
 	modifyPrefix = colors.Modified("modify ")
 	createPrefix = colors.Success("create ")
+	deletePrefix = colors.Error("delete ")
 	removePrefix = func(s string) string {
-		return strings.TrimPrefix(strings.TrimPrefix(s, modifyPrefix), createPrefix)
+		return strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(s, modifyPrefix), createPrefix), deletePrefix)
 	}
 )
 
-// SourceModification describes modified and created files in the source code after a run.
+// SourceModification describes modified, created and deleted files in the source code after a run.
 type SourceModification struct {
 	modified map[string]struct{}
 	created  map[string]struct{}
+	deleted  map[string]struct{}
 }
 
 func NewSourceModification() SourceModification {
 	return SourceModification{
 		make(map[string]struct{}),
 		make(map[string]struct{}),
+		make(map[string]struct{}),
 	}
 }
 
@@ -45,6 +48,14 @@ func (sm SourceModification) CreatedFiles() (createdFiles []string) {
 	return
 }
 
+// DeletedFiles returns the deleted files of the source modification.
+func (sm SourceModification) DeletedFiles() (deletedFiles []string) {
+	for deleted := range sm.deleted {
+		deletedFiles = append(deletedFiles, deleted)
+	}
+	return
+}
+
 // AppendModifiedFiles appends modified files in the source modification that are not already documented.
 func (sm *SourceModification) AppendModifiedFiles(modifiedFiles ...string) {
 	for _, modifiedFile := range modifiedFiles {
@@ -67,10 +78,21 @@ func (sm *SourceModification) AppendCreatedFiles(createdFiles ...string) {
 	}
 }
 
+// AppendDeletedFiles appends deleted files in the source modification that are not already documented.
+// The deleted files are no longer documented as modified or created.
+func (sm *SourceModification) AppendDeletedFiles(deletedFiles ...string) {
+	for _, deletedFile := range deletedFiles {
+		delete(sm.modified, deletedFile)
+		delete(sm.created, deletedFile)
+		sm.deleted[deletedFile] = struct{}{}
+	}
+}
+
 // Merge merges new source modification to an existing one.
 func (sm *SourceModification) Merge(newSm SourceModification) {
 	sm.AppendModifiedFiles(newSm.ModifiedFiles()...)
 	sm.AppendCreatedFiles(newSm.CreatedFiles()...)
+	sm.AppendDeletedFiles(newSm.DeletedFiles()...)
 }
 
 // String convert to string value.
@@ -93,6 +115,14 @@ func (sm *SourceModification) String() (string, error) {
 		}
 		files = append(files, createPrefix+relativePath)
 	}
+	for _, deleted := range sm.DeletedFiles() {
+		// get the relative app path from the current directory
+		relativePath, err := xfilepath.RelativePath(deleted)
+		if err != nil {
+			return "", err
+		}
+		files = append(files, deletePrefix+relativePath)
+	}
 
 	// sort filenames without prefix
 	sort.Slice(files, func(i, j int) bool {
@@ -81,3 +81,15 @@ func TestMerge(t *testing.T) {
 	require.Subset(t, sm1.ModifiedFiles(), []string{"foo1", "foo2", "foo3", "foo4", "foo5"})
 	require.Subset(t, sm1.CreatedFiles(), []string{"bar1", "bar2", "bar3"})
 }
+
+func TestAppendDeletedFiles(t *testing.T) {
+	sm := sourceModificationExample()
+	sm.AppendDeletedFiles("mfoo", "cfoo", "dfoo")
+	require.ElementsMatch(t, []string{"mfoo", "cfoo", "dfoo"}, sm.DeletedFiles())
+	require.NotContains(t, sm.ModifiedFiles(), "mfoo")
+	require.NotContains(t, sm.CreatedFiles(), "cfoo")
+
+	// Do not append a existing element
+	sm.AppendDeletedFiles("dfoo")
+	require.Len(t, sm.DeletedFiles(), 3)
+}
@@ -0,0 +1,151 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/confile"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	typeBaseAccount            = "/cosmos.auth.v1beta1.BaseAccount"
+	typePeriodicVestingAccount = "/cosmos.vesting.v1beta1.PeriodicVestingAccount"
+)
+
+// addGenesisAccount adds an account of the config to the genesis with its vesting schedule, if any.
+func (c Chain) addGenesisAccount(
+	ctx context.Context,
+	commands chaincmdrunner.Runner,
+	accountAddress string,
+	account base.Account,
+) error {
+	coins := strings.Join(account.Coins, ",")
+	if account.Vesting == nil {
+		return commands.AddGenesisAccount(ctx, accountAddress, coins)
+	}
+
+	vesting := *account.Vesting
+	start, err := vesting.StartTime()
+	if err != nil {
+		return err
+	}
+	end, err := vesting.EndTime()
+	if err != nil {
+		return err
+	}
+
+	// The periodic vesting accounts can't be created with the chain CLI,
+	// the account is added to the genesis and then converted into a vesting account.
+	if vesting.IsPeriodic() {
+		if err := commands.AddGenesisAccount(ctx, accountAddress, coins); err != nil {
+			return err
+		}
+		return c.setPeriodicVesting(accountAddress, vesting, start.Unix(), end.Unix())
+	}
+
+	var options []chaincmd.VestingOption
+	if !start.IsZero() {
+		options = append(options, chaincmd.VestingWithStartTime(start.Unix()))
+	}
+	return commands.AddVestingAccount(
+		ctx,
+		accountAddress,
+		coins,
+		strings.Join(vesting.Coins, ","),
+		end.Unix(),
+		options...,
+	)
+}
+
+// setPeriodicVesting replaces the base account of the genesis with the address
+// by a periodic vesting account with the vesting schedule.
+func (c Chain) setPeriodicVesting(accountAddress string, vesting base.Vesting, start, end int64) error {
+	var (
+		originalVesting sdk.Coins
+		periods         []interface{}
+	)
+	for _, p := range vesting.Periods {
+		length, err := p.Duration()
+		if err != nil {
+			return err
+		}
+		coins, err := sdk.ParseCoinsNormalized(strings.Join(p.Coins, ","))
+		if err != nil {
+			return err
+		}
+		originalVesting = originalVesting.Add(coins...)
+		periods = append(periods, map[string]interface{}{
+			"length": fmt.Sprintf("%d", int64(length.Seconds())),
+			"amount": coinsToGenesis(coins),
+		})
+	}
+
+	path, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	genesis := make(map[string]interface{})
+	cf := confile.New(confile.DefaultJSONEncodingCreator, path)
+	if err := cf.Load(&genesis); err != nil {
+		return err
+	}
+
+	appState, _ := genesis["app_state"].(map[string]interface{})
+	auth, _ := appState["auth"].(map[string]interface{})
+	accounts, _ := auth["accounts"].([]interface{})
+	for i, a := range accounts {
+		account, ok := a.(map[string]interface{})
+		if !ok || account["@type"] != typeBaseAccount || account["address"] != accountAddress {
+			continue
+		}
+
+		baseAccount := make(map[string]interface{}, len(account))
+		for k, v := range account {
+			if k != "@type" {
+				baseAccount[k] = v
+			}
+		}
+
+		accounts[i] = map[string]interface{}{
+			"@type": typePeriodicVestingAccount,
+			"base_vesting_account": map[string]interface{}{
+				"base_account":      baseAccount,
+				"original_vesting":  coinsToGenesis(originalVesting),
+				"delegated_free":    []interface{}{},
+				"delegated_vesting": []interface{}{},
+				"end_time":          fmt.Sprintf("%d", end),
+			},
+			"start_time":      fmt.Sprintf("%d", start),
+			"vesting_periods": periods,
+		}
+		return cf.Save(genesis)
+	}
+
+	return errors.Errorf("account %s not found in the genesis", accountAddress)
+}
+
+// moduleAccountAddress returns the address of the module account with the address prefix.
+func moduleAccountAddress(moduleName, prefix string) (string, error) {
+	return bech32.ConvertAndEncode(prefix, address.Module(moduleName))
+}
+
+func coinsToGenesis(coins sdk.Coins) []interface{} {
+	genesisCoins := make([]interface{}, len(coins))
+	for i, coin := range coins {
+		genesisCoins[i] = map[string]interface{}{
+			"denom":  coin.Denom,
+			"amount": coin.Amount.String(),
+		}
+	}
+	return genesisCoins
+}
@@ -0,0 +1,227 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+	"github.com/ignite/cli/v29/ignite/pkg/gotest"
+)
+
+const (
+	// DefaultBenchBaseline is the default path of the gas baseline, relative to the app.
+	DefaultBenchBaseline = "bench.json"
+
+	// benchGasMetric is the unit of the gas used per message reported by the
+	// message benchmarks scaffolded in the integration test packages.
+	benchGasMetric = "gas/op"
+
+	// benchPrefix is the prefix of the name of the message benchmarks.
+	benchPrefix = "Benchmark"
+)
+
+type benchOptions struct {
+	modules   []string
+	benchtime string
+}
+
+// BenchOption configures the benchmarks of the chain.
+type BenchOption func(*benchOptions)
+
+// BenchWithModules restricts the benchmarks to the messages of the given modules.
+func BenchWithModules(modules ...string) BenchOption {
+	return func(o *benchOptions) {
+		o.modules = modules
+	}
+}
+
+// BenchWithBenchtime sets the run time of each benchmark, like "2s" or "100x".
+func BenchWithBenchtime(benchtime string) BenchOption {
+	return func(o *benchOptions) {
+		o.benchtime = benchtime
+	}
+}
+
+// BenchResult is the gas used and the time taken to deliver a message type.
+type BenchResult struct {
+	Module  string `json:"module"`
+	Message string `json:"message"`
+	GasUsed uint64 `json:"gas_used"`
+	NsPerOp int64  `json:"ns_per_op"`
+}
+
+// BenchBaseline is the baseline of the gas used by the message types of the chain.
+type BenchBaseline struct {
+	Results []BenchResult `json:"results"`
+}
+
+// LoadBenchBaseline reads the gas baseline from the JSON file.
+// The error satisfies os.ErrNotExist when the file doesn't exist.
+func LoadBenchBaseline(path string) (BenchBaseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return BenchBaseline{}, err
+	}
+
+	var baseline BenchBaseline
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return BenchBaseline{}, errors.Wrapf(err, "invalid baseline %s", path)
+	}
+	return baseline, nil
+}
+
+// Save writes the gas baseline to the JSON file.
+func (b BenchBaseline) Save(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Find returns the baseline of the message type of the module.
+func (b BenchBaseline) Find(module, message string) (BenchResult, bool) {
+	for _, r := range b.Results {
+		if r.Module == module && r.Message == message {
+			return r, true
+		}
+	}
+	return BenchResult{}, false
+}
+
+// Update returns the baseline with the results replacing the baselines of their message types.
+// The baselines of the message types without results are kept.
+func (b BenchBaseline) Update(results []BenchResult) BenchBaseline {
+	var updated BenchBaseline
+	for _, r := range b.Results {
+		if !slices.ContainsFunc(results, func(result BenchResult) bool {
+			return result.Module == r.Module && result.Message == r.Message
+		}) {
+			updated.Results = append(updated.Results, r)
+		}
+	}
+	updated.Results = append(updated.Results, results...)
+	sortBenchResults(updated.Results)
+	return updated
+}
+
+// BenchComparison is the comparison of the result of a message type with its baseline.
+type BenchComparison struct {
+	BenchResult
+
+	// Baseline is the baseline of the message type, nil when the message type has no baseline.
+	Baseline *BenchResult
+
+	// GasChange is the change of the gas used compared to the baseline, in percent.
+	GasChange float64
+
+	// Regressed is true when the gas used increased beyond the threshold.
+	Regressed bool
+}
+
+// Compare compares the results with the baseline, the gas used by a message
+// type regresses when it increases by more than threshold percent.
+func (b BenchBaseline) Compare(results []BenchResult, threshold float64) []BenchComparison {
+	comparisons := make([]BenchComparison, 0, len(results))
+	for _, r := range results {
+		c := BenchComparison{BenchResult: r}
+		if base, ok := b.Find(r.Module, r.Message); ok {
+			c.Baseline = &base
+			if base.GasUsed > 0 {
+				c.GasChange = (float64(r.GasUsed) - float64(base.GasUsed)) / float64(base.GasUsed) * 100
+			}
+			c.Regressed = float64(r.GasUsed) > float64(base.GasUsed)*(1+threshold/100)
+		}
+		comparisons = append(comparisons, c)
+	}
+	return comparisons
+}
+
+// Bench runs the message benchmarks of the modules and returns the gas used
+// and the time taken to deliver each message type in the in-process app.
+func (c *Chain) Bench(ctx context.Context, options ...BenchOption) ([]BenchResult, error) {
+	var o benchOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	packages, err := gocmd.List(ctx, c.app.Path, []string{"./..."})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkModules(packages, o.modules); err != nil {
+		return nil, err
+	}
+
+	var benchPackages []string
+	for _, pkg := range packages {
+		module := c.moduleOf(pkg)
+		if module != "" && (len(o.modules) == 0 || slices.Contains(o.modules, module)) {
+			benchPackages = append(benchPackages, pkg)
+		}
+	}
+	if len(benchPackages) == 0 {
+		return nil, nil
+	}
+
+	c.ev.Send("Running the message benchmarks...", events.ProgressUpdate())
+
+	flags := []string{"-run=^$", fmt.Sprintf("-bench=^%sMsg", benchPrefix)}
+	if o.benchtime != "" {
+		flags = append(flags, "-benchtime="+o.benchtime)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := gocmd.Test(
+		ctx,
+		c.app.Path,
+		append(flags, benchPackages...),
+		exec.StepOption(step.Stdout(&stdout)),
+		exec.StepOption(step.Stderr(&stderr)),
+	); err != nil {
+		return nil, errors.Wrapf(err, "benchmarks failed:\n%s%s", stdout.String(), stderr.String())
+	}
+
+	benchmarks, err := gotest.ParseBenchmarks(&stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []BenchResult
+	for _, b := range benchmarks {
+		// Only the message benchmarks report the gas used
+		gas, ok := b.Metrics[benchGasMetric]
+		if !ok {
+			continue
+		}
+		results = append(results, BenchResult{
+			Module:  c.moduleOf(b.Package),
+			Message: strings.TrimPrefix(b.Name, benchPrefix),
+			GasUsed: uint64(math.Round(gas)),
+			NsPerOp: int64(math.Round(b.Metrics[gotest.MetricNsPerOp])),
+		})
+	}
+	sortBenchResults(results)
+
+	return results, nil
+}
+
+func sortBenchResults(results []BenchResult) {
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Module != results[j].Module {
+			return results[i].Module < results[j].Module
+		}
+		return results[i].Message < results[j].Message
+	})
+}
@@ -0,0 +1,62 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBenchBaseline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultBenchBaseline)
+
+	_, err := LoadBenchBaseline(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	baseline := BenchBaseline{}.Update([]BenchResult{
+		{Module: "foo", Message: "MsgB", GasUsed: 1000, NsPerOp: 10},
+		{Module: "bar", Message: "MsgA", GasUsed: 500, NsPerOp: 20},
+	})
+	require.NoError(t, baseline.Save(path))
+
+	loaded, err := LoadBenchBaseline(path)
+	require.NoError(t, err)
+	require.Equal(t, baseline, loaded)
+	require.Equal(t, "bar", loaded.Results[0].Module)
+
+	// The results of a module only replace the baselines of their message types
+	updated := loaded.Update([]BenchResult{{Module: "foo", Message: "MsgB", GasUsed: 1100}})
+	require.Equal(t, []BenchResult{
+		{Module: "bar", Message: "MsgA", GasUsed: 500, NsPerOp: 20},
+		{Module: "foo", Message: "MsgB", GasUsed: 1100},
+	}, updated.Results)
+}
+
+func TestBenchBaselineCompare(t *testing.T) {
+	baseline := BenchBaseline{Results: []BenchResult{
+		{Module: "foo", Message: "MsgA", GasUsed: 1000},
+		{Module: "foo", Message: "MsgB", GasUsed: 1000},
+		{Module: "foo", Message: "MsgC", GasUsed: 1000},
+	}}
+
+	comparisons := baseline.Compare([]BenchResult{
+		{Module: "foo", Message: "MsgA", GasUsed: 1050},
+		{Module: "foo", Message: "MsgB", GasUsed: 1051},
+		{Module: "foo", Message: "MsgC", GasUsed: 900},
+		{Module: "foo", Message: "MsgD", GasUsed: 2000},
+	}, 5)
+	require.Len(t, comparisons, 4)
+
+	require.False(t, comparisons[0].Regressed)
+	require.InDelta(t, 5, comparisons[0].GasChange, 1e-9)
+
+	require.True(t, comparisons[1].Regressed)
+
+	require.False(t, comparisons[2].Regressed)
+	require.InDelta(t, -10, comparisons[2].GasChange, 1e-9)
+
+	// A message type without baseline never regresses
+	require.Nil(t, comparisons[3].Baseline)
+	require.False(t, comparisons[3].Regressed)
+}
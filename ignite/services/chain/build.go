@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/ignite/cli/v29/ignite/pkg/archive"
 	"github.com/ignite/cli/v29/ignite/pkg/cache"
@@ -30,6 +31,10 @@ const (
 	consumerDevel                = "consumer_devel"
 )
 
+// buildMu serializes the builds of the chains served by the same process,
+// which can share their source code and so their binary.
+var buildMu sync.Mutex
+
 // Build builds and installs app binaries.
 func (c *Chain) Build(
 	ctx context.Context,
@@ -49,13 +54,47 @@ func (c *Chain) Build(
 	return c.Binary()
 }
 
+// BuildTarget builds the app binary for a GOOS:GOARCH target and saves it in the output directory.
+func (c *Chain) BuildTarget(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	buildTags []string,
+	output, target string,
+	skipProto bool,
+) (binaryName string, err error) {
+	goos, goarch, err := gocmd.ParseTarget(target)
+	if err != nil {
+		return "", err
+	}
+
+	if err := c.setup(); err != nil {
+		return "", err
+	}
+
+	buildOptions := []exec.Option{
+		exec.StepOption(step.Env(
+			cmdrunner.Env(gocmd.EnvGOOS, goos),
+			cmdrunner.Env(gocmd.EnvGOARCH, goarch),
+		)),
+	}
+	if err := c.build(ctx, cacheStorage, buildTags, output, skipProto, false, false, buildOptions...); err != nil {
+		return "", err
+	}
+
+	return c.Binary()
+}
+
 func (c *Chain) build(
 	ctx context.Context,
 	cacheStorage cache.Storage,
 	buildTags []string,
 	output string,
 	skipProto, generateClients, debug bool,
+	buildOptions ...exec.Option,
 ) (err error) {
+	buildMu.Lock()
+	defer buildMu.Unlock()
+
 	defer func() {
 		var exitErr *exec.ExitError
 
@@ -102,7 +141,7 @@ func (c *Chain) build(
 		return err
 	}
 
-	return gocmd.BuildPath(ctx, output, binary, path, buildFlags)
+	return gocmd.BuildPath(ctx, output, binary, path, buildFlags, buildOptions...)
 }
 
 // BuildRelease builds binaries for a release. targets is a list
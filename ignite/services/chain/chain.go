@@ -43,6 +43,8 @@ type (
 		serveCancel    context.CancelFunc
 		serveRefresher chan struct{}
 		served         bool
+		forked         bool
+		stateInspector *StateInspector
 
 		ev          events.Bus
 		logOutputer uilog.Outputer
@@ -72,6 +74,9 @@ type (
 
 		// path of a custom config file
 		ConfigFile string
+
+		// configModifiers modify the config once it is read
+		configModifiers []ConfigModifier
 	}
 
 	version struct {
@@ -81,6 +86,9 @@ type (
 
 	// Option configures Chain.
 	Option func(*Chain)
+
+	// ConfigModifier modifies the config of the chain once it is read.
+	ConfigModifier func(*chainconfig.Config) error
 )
 
 // ID replaces chain's id with given id.
@@ -111,6 +119,14 @@ func ConfigFile(configFile string) Option {
 	}
 }
 
+// ModifyConfig modifies the config of the chain each time it is read.
+// It allows to change the config without modifying the config file.
+func ModifyConfig(modifier ConfigModifier) Option {
+	return func(c *Chain) {
+		c.options.configModifiers = append(c.options.configModifiers, modifier)
+	}
+}
+
 // WithOutputer sets the CLI outputer for the chain.
 func WithOutputer(s uilog.Outputer) Option {
 	return func(c *Chain) {
@@ -239,6 +255,25 @@ func (c *Chain) RPCPublicAddress() (string, error) {
 	return rpcAddress, nil
 }
 
+// APIAddress returns the address of the API of the first validator.
+func (c *Chain) APIAddress() (string, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+
+	validator, err := chainconfig.FirstValidator(conf)
+	if err != nil {
+		return "", err
+	}
+
+	servers, err := validator.GetServers()
+	if err != nil {
+		return "", err
+	}
+	return servers.API.Address, nil
+}
+
 // ConfigPath returns the config path of the chain.
 // Empty string means that the chain has no defined config.
 func (c *Chain) ConfigPath() string {
@@ -254,11 +289,20 @@ func (c *Chain) ConfigPath() string {
 
 // Config returns the config of the chain.
 func (c *Chain) Config() (*chainconfig.Config, error) {
-	configPath := c.ConfigPath()
-	if configPath == "" {
-		return chainconfig.DefaultChainConfig(), nil
+	conf := chainconfig.DefaultChainConfig()
+	if configPath := c.ConfigPath(); configPath != "" {
+		var err error
+		if conf, err = chainconfig.ParseFile(configPath); err != nil {
+			return conf, err
+		}
+	}
+
+	for _, modify := range c.options.configModifiers {
+		if err := modify(conf); err != nil {
+			return nil, err
+		}
 	}
-	return chainconfig.ParseFile(configPath)
+	return conf, nil
 }
 
 // ID returns the chain's id.
@@ -0,0 +1,248 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/imdario/mergo"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis"
+)
+
+// genesisStateMessage is the name of the proto message holding the genesis state of a module.
+const genesisStateMessage = "GenesisState"
+
+// loadGenesisFixtures reads the fixture files, validates them against the genesis
+// proto of their module and returns the app state they define.
+// The fixtures of a same module are merged in the order they are listed.
+func (c Chain) loadGenesisFixtures(ctx context.Context, fixtures []base.GenesisFixture) (map[string]interface{}, error) {
+	cfg, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	modules, err := module.Discover(ctx, c.app.Path, c.app.Path, module.WithProtoDir(cfg.Build.Proto.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	appState := make(map[string]interface{})
+	for _, f := range fixtures {
+		m, ok := findModule(modules, f.Module)
+		if !ok {
+			return nil, errors.Errorf("genesis fixture %s: module %s not found in the app", f.Path, f.Module)
+		}
+
+		path := f.Path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(c.app.Path, path)
+		}
+
+		state, err := readGenesisFixture(path)
+		if err != nil {
+			return nil, errors.Errorf("genesis fixture %s: %w", f.Path, err)
+		}
+
+		if err := validateGenesisState(m.Pkg, state); err != nil {
+			return nil, errors.Errorf("genesis fixture %s: %w", f.Path, err)
+		}
+
+		if err := mergo.Merge(&appState, map[string]interface{}{f.Module: state}, mergo.WithOverride); err != nil {
+			return nil, err
+		}
+	}
+	return appState, nil
+}
+
+func findModule(modules []module.Module, name string) (module.Module, bool) {
+	for _, m := range modules {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return module.Module{}, false
+}
+
+// readGenesisFixture reads the genesis state of a JSON or YAML fixture file.
+func readGenesisFixture(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	state := make(map[string]interface{})
+	switch filepath.Ext(path) {
+	case ".json":
+		// Keep numbers as they are written to avoid losing the precision of large integers
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.UseNumber()
+		err = d.Decode(&state)
+	case ".yml", ".yaml":
+		err = yaml.Unmarshal(data, &state)
+	default:
+		return nil, errors.New("the file must be a JSON or YAML file")
+	}
+	if err != nil {
+		return nil, errors.Errorf("invalid file: %w", err)
+	}
+	return state, nil
+}
+
+// validateGenesisState checks that the state matches the genesis state proto message of the package.
+func validateGenesisState(pkg protoanalysis.Package, state map[string]interface{}) error {
+	genesis, err := pkg.MessageByName(genesisStateMessage)
+	if err != nil {
+		return errors.Errorf("%s not found in proto package %s", genesisStateMessage, pkg.Name)
+	}
+	return validateProtoMessage(pkg, genesis, state, "")
+}
+
+func validateProtoMessage(pkg protoanalysis.Package, msg protoanalysis.Message, value map[string]interface{}, path string) error {
+	// Sort the keys to always report the same error
+	keys := make([]string, 0, len(value))
+	for k := range value {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fieldPath := k
+		if path != "" {
+			fieldPath = fmt.Sprintf("%s.%s", path, k)
+		}
+
+		fieldType, ok := protoFieldType(msg, k)
+		if !ok {
+			return errors.Errorf("%s: unknown field of %s", fieldPath, msg.Name)
+		}
+		if err := validateProtoValue(pkg, fieldType, value[k], fieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateProtoValue(pkg protoanalysis.Package, fieldType string, value interface{}, path string) error {
+	msg, isMessage := protoMessageByType(pkg, fieldType)
+
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		// Repeated fields are not flagged by the proto analysis,
+		// so the items of any list are validated against the field type.
+		for i, item := range v {
+			if err := validateProtoValue(pkg, fieldType, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		if isMessage {
+			return validateProtoMessage(pkg, msg, v, path)
+		}
+		if isProtoScalar(fieldType) {
+			return errors.Errorf("%s: expected a %s value", path, fieldType)
+		}
+		// Messages from other packages and maps are not validated
+		return nil
+	}
+
+	if isMessage {
+		return errors.Errorf("%s: expected a %s object", path, msg.Name)
+	}
+	return validateProtoScalar(fieldType, value, path)
+}
+
+func validateProtoScalar(fieldType string, value interface{}, path string) error {
+	var valid bool
+	switch fieldType {
+	case "string":
+		_, valid = value.(string)
+	case "bytes":
+		// Bytes are base64 encoded
+		_, valid = value.(string)
+	case "bool":
+		_, valid = value.(bool)
+	case "int32", "int64", "sint32", "sint64", "sfixed32", "sfixed64":
+		_, err := strconv.ParseInt(fmt.Sprint(value), 10, 64)
+		valid = err == nil
+	case "uint32", "uint64", "fixed32", "fixed64":
+		_, err := strconv.ParseUint(fmt.Sprint(value), 10, 64)
+		valid = err == nil
+	case "float", "double":
+		_, err := strconv.ParseFloat(fmt.Sprint(value), 64)
+		valid = err == nil
+	default:
+		// Enums and types from other packages are not validated
+		valid = true
+	}
+	if !valid {
+		return errors.Errorf("%s: invalid %s value %v", path, fieldType, value)
+	}
+	return nil
+}
+
+// protoFieldType returns the type of the message field, the key
+// can either be the field name or its JSON name in camel case.
+func protoFieldType(msg protoanalysis.Message, key string) (string, bool) {
+	if t, ok := msg.Fields[key]; ok {
+		return t, true
+	}
+	for name, t := range msg.Fields {
+		if protoJSONName(name) == key {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// protoMessageByType returns the message of the package with the field type.
+func protoMessageByType(pkg protoanalysis.Package, fieldType string) (protoanalysis.Message, bool) {
+	name := strings.TrimPrefix(fieldType, pkg.Name+".")
+	msg, err := pkg.MessageByName(strings.ReplaceAll(name, ".", "_"))
+	return msg, err == nil
+}
+
+// protoJSONName returns the JSON name of a proto field as generated by protoc.
+func protoJSONName(name string) string {
+	var (
+		b     strings.Builder
+		upper bool
+	)
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func isProtoScalar(fieldType string) bool {
+	switch fieldType {
+	case "string", "bytes", "bool",
+		"int32", "int64", "sint32", "sint64", "sfixed32", "sfixed64",
+		"uint32", "uint64", "fixed32", "fixed64",
+		"float", "double":
+		return true
+	}
+	return false
+}
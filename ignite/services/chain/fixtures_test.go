@@ -0,0 +1,121 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis"
+)
+
+func TestValidateGenesisState(t *testing.T) {
+	pkg := protoanalysis.Package{
+		Name: "mars.blog.v1",
+		Messages: []protoanalysis.Message{
+			{
+				Name: "GenesisState",
+				Fields: map[string]string{
+					"params":     "Params",
+					"postList":   "Post",
+					"post_count": "uint64",
+				},
+			},
+			{
+				Name:   "Params",
+				Fields: map[string]string{},
+			},
+			{
+				Name: "Post",
+				Fields: map[string]string{
+					"id":      "uint64",
+					"title":   "string",
+					"creator": "string",
+					"draft":   "bool",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		state map[string]interface{}
+		err   string
+	}{
+		{
+			name: "valid state",
+			state: map[string]interface{}{
+				"params": map[string]interface{}{},
+				"postList": []interface{}{
+					map[string]interface{}{"id": "0", "title": "hello", "draft": true},
+					map[string]interface{}{"id": 1, "title": "world"},
+				},
+				"postCount": "2",
+			},
+		},
+		{
+			name: "unknown field",
+			state: map[string]interface{}{
+				"comments": []interface{}{},
+			},
+			err: "comments: unknown field of GenesisState",
+		},
+		{
+			name: "unknown record field",
+			state: map[string]interface{}{
+				"postList": []interface{}{
+					map[string]interface{}{"id": 0, "title": "hello"},
+					map[string]interface{}{"id": 1, "titel": "world"},
+				},
+			},
+			err: "postList[1].titel: unknown field of Post",
+		},
+		{
+			name: "invalid record value",
+			state: map[string]interface{}{
+				"postList": []interface{}{
+					map[string]interface{}{"id": "first"},
+				},
+			},
+			err: "postList[0].id: invalid uint64 value first",
+		},
+		{
+			name: "record not an object",
+			state: map[string]interface{}{
+				"postList": []interface{}{"hello"},
+			},
+			err: "postList[0]: expected a Post object",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGenesisState(pkg, tt.state)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReadGenesisFixture(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "posts.json")
+	yamlPath := filepath.Join(dir, "posts.yml")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"postList":[{"id":"18446744073709551615"}]}`), 0o644))
+	require.NoError(t, os.WriteFile(yamlPath, []byte("postList:\n  - id: 1\n    title: hello\n"), 0o644))
+
+	state, err := readGenesisFixture(jsonPath)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"postList": []interface{}{map[string]interface{}{"id": "18446744073709551615"}},
+	}, state)
+
+	state, err = readGenesisFixture(yamlPath)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"postList": []interface{}{map[string]interface{}{"id": 1, "title": "hello"}},
+	}, state)
+}
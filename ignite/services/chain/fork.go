@@ -0,0 +1,286 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/otiai10/copy"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/archive"
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosutil/genesis"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/tendermintrpc"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+)
+
+const (
+	// privValidatorKeyFile is the file of the consensus key of the node in the home config directory.
+	privValidatorKeyFile = "priv_validator_key.json"
+
+	// privValidatorStateFile is the file of the signing state of the node in the home data directory.
+	privValidatorStateFile = "priv_validator_state.json"
+
+	// valoperSuffix is the suffix of the address prefix of the validator operators.
+	valoperSuffix = "valoper"
+
+	// forkBlockRetryDelay is the delay between two checks of the height of a forked node.
+	forkBlockRetryDelay = time.Second
+)
+
+// ForkArgs defines the state of a network forked into a local testnet.
+type ForkArgs struct {
+	// Genesis is the path or the URL of the genesis of the network.
+	// Without a snapshot, the genesis must be exported from the network state.
+	Genesis string
+
+	// Snapshot is the path of a tar.gz archive of the data directory of a node of the network.
+	Snapshot string
+}
+
+// fork initializes the chain with the state of a network and turns it into a single validator
+// testnet. The chain is initialized with the config, the genesis of the network replaces the
+// initialized genesis and the data of the node is either restored from a snapshot or created
+// by starting the node from an exported genesis, then the in-place testnet is created with
+// the chain ID of the chain, the first validator and the accounts of the config.
+func (c *Chain) fork(ctx context.Context, runner chaincmdrunner.Runner, cfg *chainconfig.Config, args ForkArgs) error {
+	if err := c.Init(ctx, InitArgsAll); err != nil {
+		return err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	c.ev.Send("Importing the network genesis...", events.ProgressUpdate())
+
+	if err := c.importForkGenesis(ctx, args.Genesis); err != nil {
+		return err
+	}
+
+	if args.Snapshot != "" {
+		c.ev.Send("Restoring the network state from the snapshot...", events.ProgressUpdate())
+
+		if err := restoreSnapshot(home, args.Snapshot); err != nil {
+			return err
+		}
+	} else {
+		c.ev.Send("Starting the network state from the exported genesis...", events.ProgressUpdate())
+
+		pubKey, err := validatorPubKey(home)
+		if err != nil {
+			return err
+		}
+
+		genesisPath, err := c.GenesisPath()
+		if err != nil {
+			return err
+		}
+		if err := genesis.SetSingleValidator(genesisPath, pubKey); err != nil {
+			return err
+		}
+
+		// The in-place testnet is created from the state of a committed block
+		start := func(ctx context.Context) error {
+			return c.Start(ctx, runner, cfg)
+		}
+		if err := c.runUntilNewBlock(ctx, cfg, start); err != nil {
+			return err
+		}
+	}
+
+	c.ev.Send("Creating the testnet from the network state...", events.ProgressUpdate())
+
+	inPlaceArgs, err := c.forkInPlaceArgs(ctx, runner, cfg)
+	if err != nil {
+		return err
+	}
+	inPlace := func(ctx context.Context) error {
+		return c.InPlace(ctx, runner, inPlaceArgs)
+	}
+	return c.runUntilNewBlock(ctx, cfg, inPlace)
+}
+
+// forkInPlaceArgs returns the arguments to create the testnet with the accounts initialized from the config.
+func (c *Chain) forkInPlaceArgs(ctx context.Context, runner chaincmdrunner.Runner, cfg *chainconfig.Config) (InPlaceArgs, error) {
+	chainID, err := c.ID()
+	if err != nil {
+		return InPlaceArgs{}, err
+	}
+
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return InPlaceArgs{}, err
+	}
+
+	operator, err := runner.ShowAccount(ctx, validator.Name)
+	if err != nil {
+		return InPlaceArgs{}, err
+	}
+	prefix, err := cosmosutil.GetAddressPrefix(operator.Address)
+	if err != nil {
+		return InPlaceArgs{}, err
+	}
+	operatorAddress, err := cosmosutil.ChangeAddressPrefix(operator.Address, prefix+valoperSuffix)
+	if err != nil {
+		return InPlaceArgs{}, err
+	}
+
+	accounts := make([]string, 0, len(cfg.Accounts))
+	for _, a := range cfg.Accounts {
+		account, err := runner.ShowAccount(ctx, a.Name)
+		if err != nil {
+			return InPlaceArgs{}, err
+		}
+		accounts = append(accounts, account.Address)
+	}
+
+	return InPlaceArgs{
+		NewChainID:         chainID,
+		NewOperatorAddress: operatorAddress,
+		AccountsToFund:     strings.Join(accounts, ","),
+	}, nil
+}
+
+// importForkGenesis replaces the genesis of the chain with the genesis of a file or a URL.
+func (c *Chain) importForkGenesis(ctx context.Context, source string) error {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	if !xurl.IsHTTP(source) {
+		return copy.Copy(source, genesisPath)
+	}
+
+	g, err := genesis.FromURL(ctx, source, genesisPath)
+	if err != nil {
+		return errors.Errorf("cannot download genesis from %s: %w", source, err)
+	}
+	return g.Close()
+}
+
+// runUntilNewBlock runs a node until a block is committed after the node is started and then stops it.
+func (c *Chain) runUntilNewBlock(ctx context.Context, cfg *chainconfig.Config, run func(context.Context) error) error {
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return err
+	}
+
+	servers, err := validator.GetServers()
+	if err != nil {
+		return err
+	}
+
+	rpcAddr, err := xurl.HTTP(servers.RPC.Address)
+	if err != nil {
+		return errors.Errorf("invalid rpc address format %s: %w", servers.RPC.Address, err)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	runErr := make(chan error, 1)
+	go func() {
+		err := run(runCtx)
+		cancel()
+		runErr <- err
+	}()
+
+	// The first height returned by the node is the height of the state it was started from
+	var (
+		rpc               = tendermintrpc.New(rpcAddr)
+		startHeight int64 = -1
+	)
+	waitBlock := func() error {
+		height, err := rpc.LatestBlockHeight(runCtx)
+		if err != nil {
+			return err
+		}
+		if startHeight < 0 {
+			startHeight = height
+		}
+		if height <= startHeight {
+			return errors.New("waiting for a new block")
+		}
+		return nil
+	}
+
+	err = backoff.Retry(waitBlock, backoff.WithContext(backoff.NewConstantBackOff(forkBlockRetryDelay), runCtx))
+	cancel()
+	nodeErr := <-runErr
+
+	switch {
+	case err == nil:
+		// The node is stopped once the block is committed
+		return nil
+	case ctx.Err() != nil:
+		return ctx.Err()
+	case nodeErr != nil:
+		return nodeErr
+	default:
+		return errors.New("the node stopped before committing a block")
+	}
+}
+
+// restoreSnapshot replaces the data directory of a home with the one of a snapshot archive.
+// The signing state of the node is kept to sign the blocks of the testnet.
+func restoreSnapshot(home, snapshot string) error {
+	dataDir := filepath.Join(home, "data")
+	statePath := filepath.Join(dataDir, privValidatorStateFile)
+
+	state, err := os.ReadFile(statePath)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(snapshot)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := os.RemoveAll(dataDir); err != nil {
+		return err
+	}
+	if err := archive.ExtractArchive(home, f); err != nil {
+		return errors.Errorf("cannot extract snapshot %s: %w", snapshot, err)
+	}
+	if _, err := os.Stat(dataDir); err != nil {
+		return errors.Errorf("the snapshot %s must contain the data directory of a node", snapshot)
+	}
+
+	return os.WriteFile(statePath, state, 0o600)
+}
+
+// validatorPubKey returns the consensus public key of the node of a home.
+func validatorPubKey(home string) (ed25519.PubKey, error) {
+	data, err := os.ReadFile(filepath.Join(home, "config", privValidatorKeyFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var key struct {
+		PubKey struct {
+			Value []byte `json:"value"`
+		} `json:"pub_key"`
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, errors.Errorf("invalid validator key: %w", err)
+	}
+	if len(key.PubKey.Value) != ed25519.PubKeySize {
+		return nil, errors.New("the validator key must be an ed25519 key")
+	}
+	return key.PubKey.Value, nil
+}
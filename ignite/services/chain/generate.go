@@ -99,7 +99,7 @@ func (c *Chain) generateFromConfig(ctx context.Context, cacheStorage cache.Stora
 	// Additional code generation targets
 	var targets []GenerateTarget
 
-	if conf.Client.OpenAPI.Path != "" {
+	if conf.Client.OpenAPI.Path != "" || conf.Client.OpenAPI.V3Path != "" {
 		targets = append(targets, GenerateOpenAPI())
 	}
 
@@ -156,8 +156,8 @@ func (c *Chain) Generate(
 	}
 
 	var (
-		openAPIPath, tsClientPath, composablesPath, hooksPath string
-		updateConfig                                          bool
+		openAPIPath, openAPIV3Path, tsClientPath, composablesPath, hooksPath string
+		updateConfig                                                         bool
 	)
 
 	if targetOptions.isOpenAPIEnabled {
@@ -172,6 +172,14 @@ func (c *Chain) Generate(
 		}
 
 		options = append(options, cosmosgen.WithOpenAPIGeneration(openAPIPath))
+
+		if openAPIV3Path = conf.Client.OpenAPI.V3Path; openAPIV3Path != "" {
+			if !filepath.IsAbs(openAPIV3Path) {
+				openAPIV3Path = filepath.Join(c.app.Path, openAPIV3Path)
+			}
+
+			options = append(options, cosmosgen.WithOpenAPIV3Generation(openAPIV3Path))
+		}
 	}
 
 	if targetOptions.isTSClientEnabled {
@@ -299,6 +307,14 @@ func (c *Chain) Generate(
 				events.Icon(icons.Bullet),
 				events.ProgressFinish(),
 			)
+
+			if openAPIV3Path != "" {
+				c.ev.Send(
+					fmt.Sprintf("OpenAPI 3.1 path: %s", openAPIV3Path),
+					events.Icon(icons.Bullet),
+					events.ProgressFinish(),
+				)
+			}
 		}
 	}
 
@@ -9,9 +9,12 @@ import (
 	"github.com/imdario/mergo"
 
 	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
 	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/view/accountview"
 	"github.com/ignite/cli/v29/ignite/pkg/confile"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/events"
 )
 
@@ -112,7 +115,7 @@ func (c *Chain) InitChain(ctx context.Context, initConfiguration, initGenesis bo
 		}
 
 		// update genesis file with the genesis values defined in the config
-		if err := c.UpdateGenesisFile(conf.Genesis); err != nil {
+		if err := c.UpdateGenesisFile(ctx, conf.Genesis); err != nil {
 			return err
 		}
 	}
@@ -129,10 +132,18 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 
 	c.ev.Send("Initializing accounts...", events.ProgressUpdate())
 
-	var accounts accountview.Accounts
+	var (
+		accounts      accountview.Accounts
+		addressPrefix string
+	)
 
 	// add accounts from config into genesis
 	for _, account := range cfg.Accounts {
+		// module accounts are added once the address prefix is known
+		if account.Module != "" {
+			continue
+		}
+
 		var generatedAccount chaincmdrunner.Account
 		accountAddress := account.Address
 
@@ -152,9 +163,14 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 			accountAddress = generatedAccount.Address
 		}
 
-		coins := strings.Join(account.Coins, ",")
-		if err := commands.AddGenesisAccount(ctx, accountAddress, coins); err != nil {
-			return err
+		if err := c.addGenesisAccount(ctx, commands, accountAddress, account); err != nil {
+			return errors.Errorf("account %s: %w", account.Name, err)
+		}
+
+		if addressPrefix == "" {
+			if addressPrefix, err = cosmosutil.GetAddressPrefix(accountAddress); err != nil {
+				return err
+			}
 		}
 
 		if account.Address == "" {
@@ -168,6 +184,31 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 		}
 	}
 
+	// add the module accounts from config into genesis
+	for _, account := range cfg.Accounts {
+		if account.Module == "" {
+			continue
+		}
+		if addressPrefix == "" {
+			return errors.Errorf(
+				"can't determine the address prefix of module account %s, at least one regular account must be defined",
+				account.Name,
+			)
+		}
+
+		accountAddress, err := moduleAccountAddress(account.Module, addressPrefix)
+		if err != nil {
+			return err
+		}
+
+		coins := strings.Join(account.Coins, ",")
+		if err := commands.AddModuleAccount(ctx, accountAddress, coins, account.Module); err != nil {
+			return errors.Errorf("account %s: %w", account.Name, err)
+		}
+
+		accounts = accounts.Append(accountview.NewAccount(account.Name, accountAddress))
+	}
+
 	c.ev.SendView(accounts, events.ProgressFinish())
 
 	// 0 length validator set when using network config
@@ -237,7 +278,14 @@ func (c *Chain) IsInitialized() (bool, error) {
 
 // UpdateGenesisFile updates the chain genesis with a generic map of data.
 // Updates are made using an override merge strategy.
-func (c Chain) UpdateGenesisFile(data map[string]interface{}) error {
+// The fixture files listed in the data are validated against the genesis
+// proto of their module and merged into the app state after the data.
+func (c Chain) UpdateGenesisFile(ctx context.Context, data map[string]interface{}) error {
+	data, fixtures, err := base.SplitGenesisFixtures(data)
+	if err != nil {
+		return err
+	}
+
 	path, err := c.GenesisPath()
 	if err != nil {
 		return err
@@ -253,6 +301,18 @@ func (c Chain) UpdateGenesisFile(data map[string]interface{}) error {
 		return err
 	}
 
+	if len(fixtures) > 0 {
+		appState, err := c.loadGenesisFixtures(ctx, fixtures)
+		if err != nil {
+			return err
+		}
+
+		data := map[string]interface{}{"app_state": appState}
+		if err := mergo.Merge(&genesis, data, mergo.WithOverride); err != nil {
+			return err
+		}
+	}
+
 	return cf.Save(genesis)
 }
 
@@ -280,9 +340,12 @@ type Account struct {
 	Coins    string
 }
 
-func createValidatorFromConfig(conf *chainconfig.Config) (validator Validator) {
-	// Currently, we support the config file with one valid validator.
-	validatorFromConfig := conf.Validators[0]
+func createValidatorFromConfig(conf *chainconfig.Config) Validator {
+	// The chain node runs the first validator of the config.
+	return createValidator(conf.Validators[0])
+}
+
+func createValidator(validatorFromConfig chainconfig.Validator) (validator Validator) {
 	validator.Name = validatorFromConfig.Name
 	validator.StakingAmount = validatorFromConfig.Bonded
 
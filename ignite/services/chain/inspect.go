@@ -0,0 +1,290 @@
+package chain
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+)
+
+const (
+	// inspectMaxTxs is the number of recent transactions kept by the state inspector.
+	inspectMaxTxs = 10
+
+	// inspectMaxBlocks is the maximum number of blocks scanned for transactions by an inspection.
+	inspectMaxBlocks = 20
+
+	// inspectStoresInterval is the number of blocks between two updates of the store sizes.
+	inspectStoresInterval = 5
+)
+
+type (
+	// ChainState is a snapshot of the state of the served chain.
+	ChainState struct {
+		// Height is the height of the latest block.
+		Height int64
+
+		// BlockTime is the time of the latest block.
+		BlockTime time.Time
+
+		// BlockInterval is the time between the two latest blocks.
+		BlockInterval time.Duration
+
+		// MempoolSize is the number of transactions waiting in the mempool.
+		MempoolSize int
+
+		// Txs are the recent transactions, the latest first.
+		Txs []StateTx
+
+		// Stores are the sizes of the module stores.
+		Stores []StateStore
+	}
+
+	// StateTx is a transaction included in a block.
+	StateTx struct {
+		Height int64
+		Hash   string
+		Code   uint32
+		Log    string
+		Msgs   []StateMsg
+		Events []abci.Event
+	}
+
+	// StateMsg is a message of a transaction with its JSON representation.
+	// The JSON is empty when the message can't be decoded with the chain proto files.
+	StateMsg struct {
+		Type string
+		JSON string
+	}
+
+	// StateStore is the size of a module store.
+	StateStore struct {
+		Name string
+		cosmosclient.StoreSize
+	}
+)
+
+// StateInspector collects the state of the served chain from its node.
+// The inspector is reset each time the chain is started.
+type StateInspector struct {
+	mu            sync.Mutex
+	clientOptions []cosmosclient.Option
+	client        *cosmosclient.Client
+	files         *protoregistry.Files
+	storeKeys     []string
+	storesHeight  int64
+	state         ChainState
+}
+
+// NewStateInspector creates a new state inspector.
+// The inspector collects the state once it is used to serve a chain.
+func NewStateInspector() *StateInspector {
+	return &StateInspector{}
+}
+
+// ServeStateInspector collects the state of the served chain with the inspector.
+func ServeStateInspector(i *StateInspector) ServeOption {
+	return func(c *serveOptions) {
+		c.stateInspector = i
+	}
+}
+
+// reset connects the inspector to a started node and clears the collected state.
+func (i *StateInspector) reset(options ...cosmosclient.Option) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.clientOptions = options
+	i.client = nil
+	i.files = nil
+	i.storeKeys = nil
+	i.storesHeight = 0
+	i.state = ChainState{}
+}
+
+// Inspect returns the state of the served chain.
+// The state is empty until the chain is started.
+func (i *StateInspector) Inspect(ctx context.Context) (ChainState, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.clientOptions == nil {
+		return ChainState{}, nil
+	}
+
+	if i.client == nil {
+		client, err := cosmosclient.New(ctx, i.clientOptions...)
+		if err != nil {
+			return ChainState{}, err
+		}
+		i.client = &client
+	}
+
+	status, err := i.client.Status(ctx)
+	if err != nil {
+		return i.state, err
+	}
+
+	mempool, err := i.client.RPC.NumUnconfirmedTxs(ctx)
+	if err != nil {
+		return i.state, err
+	}
+	i.state.MempoolSize = mempool.Total
+
+	height := status.SyncInfo.LatestBlockHeight
+	if height == i.state.Height {
+		return i.state, nil
+	}
+
+	fromHeight := max(i.state.Height+1, height-inspectMaxBlocks+1, 1)
+	i.state.Height = height
+	i.state.BlockTime = status.SyncInfo.LatestBlockTime
+
+	if height > 1 {
+		info, err := i.client.RPC.BlockchainInfo(ctx, height-1, height)
+		if err != nil {
+			return i.state, err
+		}
+		// Blocks are returned from the latest one
+		if metas := info.BlockMetas; len(metas) == 2 {
+			i.state.BlockInterval = metas[0].Header.Time.Sub(metas[1].Header.Time)
+		}
+	}
+
+	if err := i.collectTxs(ctx, fromHeight, height); err != nil {
+		return i.state, err
+	}
+
+	if i.storesHeight == 0 || height-i.storesHeight >= inspectStoresInterval {
+		if err := i.collectStores(ctx); err != nil {
+			return i.state, err
+		}
+		i.storesHeight = height
+	}
+	return i.state, nil
+}
+
+// collectTxs adds the transactions of the blocks to the recent transactions.
+func (i *StateInspector) collectTxs(ctx context.Context, fromHeight, toHeight int64) error {
+	for h := fromHeight; h <= toHeight; h++ {
+		txs, err := i.client.GetBlockTXs(ctx, h)
+		if err != nil {
+			return err
+		}
+
+		for _, tx := range txs {
+			stateTx := StateTx{
+				Height: tx.Raw.Height,
+				Hash:   tx.Raw.Hash.String(),
+				Code:   tx.Raw.TxResult.Code,
+				Events: tx.Raw.TxResult.Events,
+			}
+			if stateTx.Code != 0 {
+				stateTx.Log = tx.Raw.TxResult.Log
+			}
+
+			if stateTx.Msgs, err = i.decodeTxMsgs(ctx, tx.Raw.Tx); err != nil {
+				return err
+			}
+
+			i.state.Txs = append([]StateTx{stateTx}, i.state.Txs...)
+		}
+	}
+
+	if len(i.state.Txs) > inspectMaxTxs {
+		i.state.Txs = i.state.Txs[:inspectMaxTxs]
+	}
+	return nil
+}
+
+// decodeTxMsgs returns the messages of an encoded transaction.
+// The messages are decoded with the proto files of the chain, which are
+// fetched again when a message type is missing because the app changed.
+func (i *StateInspector) decodeTxMsgs(ctx context.Context, txBytes []byte) ([]StateMsg, error) {
+	// Transactions that aren't Cosmos SDK transactions are listed without messages
+	var (
+		raw  txtypes.TxRaw
+		body txtypes.TxBody
+	)
+	if raw.Unmarshal(txBytes) != nil || body.Unmarshal(raw.BodyBytes) != nil {
+		return nil, nil
+	}
+
+	var (
+		msgs      []StateMsg
+		refreshed bool
+	)
+	for _, m := range body.Messages {
+		if i.files == nil || !refreshed && !hasProtoType(i.files, m.TypeUrl) {
+			files, err := i.client.ProtoFiles(ctx)
+			if err != nil {
+				return nil, err
+			}
+			i.files, refreshed = files, true
+		}
+
+		msg := StateMsg{Type: m.TypeUrl}
+		if b, err := cosmosclient.MsgJSON(i.files, m.TypeUrl, m.Value); err == nil {
+			msg.JSON = string(b)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// collectStores updates the sizes of the module stores.
+func (i *StateInspector) collectStores(ctx context.Context) error {
+	if i.storeKeys == nil {
+		keys, err := i.client.StoreKeys(ctx)
+		if err != nil {
+			return err
+		}
+		i.storeKeys = keys
+	}
+
+	var stores []StateStore
+	for _, key := range i.storeKeys {
+		// Modules without a store can't be queried
+		size, err := i.client.StoreSize(ctx, key)
+		if err != nil {
+			continue
+		}
+		stores = append(stores, StateStore{Name: key, StoreSize: size})
+	}
+	i.state.Stores = stores
+	return nil
+}
+
+func hasProtoType(files *protoregistry.Files, typeURL string) bool {
+	_, err := files.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(typeURL, "/")))
+	return err == nil
+}
+
+// stateInspectorOptions returns the client options to inspect the node with the RPC address.
+func (c *Chain) stateInspectorOptions(rpcAddr string) ([]cosmosclient.Option, error) {
+	home, err := c.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	keyringBackend, err := c.KeyringBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	return []cosmosclient.Option{
+		cosmosclient.WithNodeAddress(rpcAddr),
+		cosmosclient.WithHome(home),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(keyringBackend)),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	_ "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient/testutil"
+)
+
+func TestStateInspectorCollectTxs(t *testing.T) {
+	var (
+		m   = testutil.NewTendermintClientMock(t)
+		ctx = context.Background()
+	)
+
+	msg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: "alice",
+		ToAddress:   "bob",
+		Amount:      sdk.NewCoins(sdk.NewCoin("token", math.NewInt(10))),
+	})
+	require.NoError(t, err)
+	body, err := (&txtypes.TxBody{Messages: []*codectypes.Any{msg}}).Marshal()
+	require.NoError(t, err)
+	tx, err := (&txtypes.TxRaw{BodyBytes: body}).Marshal()
+	require.NoError(t, err)
+
+	event := abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "10token"}}}
+	for height := int64(1); height <= 2; height++ {
+		block := tmtypes.Block{Header: tmtypes.Header{Height: height}}
+		m.On("Block", ctx, &height).Return(&ctypes.ResultBlock{Block: &block}, nil)
+	}
+	m.OnTxSearch().Return(&ctypes.ResultTxSearch{
+		Txs: []*ctypes.ResultTx{{
+			Height:   2,
+			Hash:     []byte{0xAB, 0xCD},
+			Tx:       tx,
+			TxResult: abci.ExecTxResult{Events: []abci.Event{event}},
+		}},
+		TotalCount: 1,
+	}, nil).Once()
+	m.OnTxSearch().Return(&ctypes.ResultTxSearch{}, nil)
+
+	i := &StateInspector{
+		client: &cosmosclient.Client{RPC: m},
+		files:  protoregistry.GlobalFiles,
+	}
+	require.NoError(t, i.collectTxs(ctx, 1, 2))
+
+	require.Len(t, i.state.Txs, 1)
+	got := i.state.Txs[0]
+	require.Equal(t, int64(2), got.Height)
+	require.Equal(t, "ABCD", got.Hash)
+	require.Equal(t, []abci.Event{event}, got.Events)
+	require.Len(t, got.Msgs, 1)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", got.Msgs[0].Type)
+	require.JSONEq(t, `{"fromAddress":"alice","toAddress":"bob","amount":[{"denom":"token","amount":"10"}]}`, got.Msgs[0].JSON)
+}
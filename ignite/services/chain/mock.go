@@ -0,0 +1,85 @@
+package chain
+
+import (
+	"context"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosmock"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosver"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodule"
+)
+
+// MockServer returns a server mocking the HTTP queries of the modules registered in the app,
+// which are discovered from the proto files of the app and of its Go dependencies.
+// The chain is neither built nor started.
+func (c *Chain) MockServer(ctx context.Context, cacheStorage cache.Storage, options ...cosmosmock.Option) (*cosmosmock.Server, error) {
+	c.ev.Send("Discovering the modules of the app...", events.ProgressUpdate())
+
+	modules, err := c.discoverModules(ctx, cacheStorage)
+	if err != nil {
+		return nil, err
+	}
+	return cosmosmock.New(modules, options...), nil
+}
+
+// discoverModules discovers the modules registered in the app from the app and its Go dependencies.
+func (c *Chain) discoverModules(ctx context.Context, cacheStorage cache.Storage) ([]module.Module, error) {
+	cfg, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	modFile, err := gomodule.ParseAt(c.app.Path)
+	if err != nil {
+		return nil, err
+	}
+	deps, err := gomodule.ResolveDependencies(modFile, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// The Cosmos SDK directory is required to discover the modules of
+	// the "cosmossdk.io" packages that don't contain their proto files.
+	var sdkDir string
+	for _, dep := range deps {
+		if !cosmosver.CosmosSDKModulePathPattern.MatchString(dep.Path) {
+			continue
+		}
+		if sdkDir, err = gomodule.LocatePath(ctx, cacheStorage, c.app.Path, dep); err != nil {
+			return nil, err
+		}
+		break
+	}
+	if sdkDir == "" {
+		return nil, errors.New("the app doesn't depend on the Cosmos SDK")
+	}
+
+	modules, err := module.Discover(
+		ctx,
+		c.app.Path,
+		c.app.Path,
+		module.WithProtoDir(cfg.Build.Proto.Path),
+		module.WithSDKDir(sdkDir),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dep := range deps {
+		path, err := gomodule.LocatePath(ctx, cacheStorage, c.app.Path, dep)
+		if err != nil {
+			return nil, err
+		}
+
+		depModules, err := module.Discover(ctx, c.app.Path, path, module.WithSDKDir(sdkDir))
+		if err != nil {
+			return nil, err
+		}
+		modules = append(modules, depModules...)
+	}
+
+	return modules, nil
+}
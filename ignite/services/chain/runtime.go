@@ -66,7 +66,11 @@ func (c Chain) Start(ctx context.Context, runner chaincmdrunner.Runner, cfg *cha
 	if err != nil {
 		return err
 	}
+	return c.StartValidator(ctx, runner, validator)
+}
 
+// StartValidator wraps the "appd start" command to begin running the node of a validator.
+func (c Chain) StartValidator(ctx context.Context, runner chaincmdrunner.Runner, validator chainconfig.Validator) error {
 	servers, err := validator.GetServers()
 	if err != nil {
 		return err
@@ -79,21 +83,26 @@ func (c Chain) Start(ctx context.Context, runner chaincmdrunner.Runner, cfg *cha
 
 // Configure sets the runtime configurations files for a chain (app.toml, client.toml, config.toml).
 func (c Chain) Configure(homePath, chainID string, cfg *chainconfig.Config) error {
-	if err := appTOML(homePath, cfg); err != nil {
-		return err
-	}
-	if err := clientTOML(homePath, chainID, cfg); err != nil {
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
 		return err
 	}
-	return configTOML(homePath, cfg)
+	return c.ConfigureValidator(homePath, chainID, validator)
 }
 
-func appTOML(homePath string, cfg *chainconfig.Config) error {
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
+// ConfigureValidator sets the runtime configurations files of a validator node
+// (app.toml, client.toml, config.toml).
+func (c Chain) ConfigureValidator(homePath, chainID string, validator chainconfig.Validator) error {
+	if err := appTOML(homePath, validator); err != nil {
 		return err
 	}
+	if err := clientTOML(homePath, chainID, validator); err != nil {
+		return err
+	}
+	return configTOML(homePath, validator)
+}
 
+func appTOML(homePath string, validator chainconfig.Validator) error {
 	// TODO find a better way in order to not delete comments in the toml.yml
 	path := filepath.Join(homePath, "config/app.toml")
 	appConfig, err := toml.LoadFile(path)
@@ -141,12 +150,7 @@ func appTOML(homePath string, cfg *chainconfig.Config) error {
 	return err
 }
 
-func configTOML(homePath string, cfg *chainconfig.Config) error {
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
-		return err
-	}
-
+func configTOML(homePath string, validator chainconfig.Validator) error {
 	// TODO find a better way in order to not delete comments in the toml.yml
 	path := filepath.Join(homePath, "config/config.toml")
 	tmConfig, err := toml.LoadFile(path)
@@ -194,12 +198,7 @@ func configTOML(homePath string, cfg *chainconfig.Config) error {
 	return err
 }
 
-func clientTOML(homePath, chainID string, cfg *chainconfig.Config) error {
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
-		return err
-	}
-
+func clientTOML(homePath, chainID string, validator chainconfig.Validator) error {
 	path := filepath.Join(homePath, "config/client.toml")
 	clientConfig, err := toml.LoadFile(path)
 	if os.IsNotExist(err) {
@@ -0,0 +1,239 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/pelletier/go-toml"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+)
+
+// seedRetryDelay is the delay between two attempts to connect to the node.
+const seedRetryDelay = time.Second
+
+// regexSeedPlaceholder matches the ${name} placeholders of the seed transaction bodies.
+var regexSeedPlaceholder = regexp.MustCompile(`\$\{([^{}]+)\}`)
+
+// hasSeed returns true when the config seeds the chain state once it is started.
+func hasSeed(cfg *chainconfig.Config) bool {
+	return len(cfg.Seed) > 0 || (cfg.Wasm != nil && len(cfg.Wasm.Contracts) > 0)
+}
+
+// seed deploys the wasm contracts and broadcasts the seed transactions of the
+// config once the chain produces blocks.
+// Each transaction must be included in a block before the next one is broadcasted.
+func (c *Chain) seed(ctx context.Context, cfg *chainconfig.Config) error {
+	client, err := c.seedClient(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := client.WaitForBlockHeight(ctx, 1); err != nil {
+		return err
+	}
+
+	c.ev.Send("Seeding the chain state...", events.ProgressUpdate())
+
+	files, err := client.ProtoFiles(ctx)
+	if err != nil {
+		return err
+	}
+
+	addresses, err := c.seedAddresses(client, cfg)
+	if err != nil {
+		return err
+	}
+
+	// The contracts are deployed first so their addresses can be used by the seed transactions
+	if cfg.Wasm != nil {
+		if err := c.deployWasmContracts(ctx, client, files, cfg.Wasm.Contracts, addresses); err != nil {
+			return err
+		}
+	}
+
+	for i, tx := range cfg.Seed {
+		if err := broadcastSeedTx(ctx, client, files, tx, addresses); err != nil {
+			return errors.Errorf("seed transaction %d (%s): %w", i+1, tx.Type, err)
+		}
+	}
+
+	c.ev.Send(
+		fmt.Sprintf("Chain state seeded with %d transaction(s)", len(cfg.Seed)),
+		events.ProgressFinish(),
+	)
+	return nil
+}
+
+// seedClient returns a client connected to the node of the chain,
+// it waits for the node to be started.
+func (c *Chain) seedClient(ctx context.Context, cfg *chainconfig.Config) (cosmosclient.Client, error) {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	keyringBackend, err := c.KeyringBackend()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+	servers, err := validator.GetServers()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+	rpcAddr, err := xurl.HTTP(servers.RPC.Address)
+	if err != nil {
+		return cosmosclient.Client{}, errors.Errorf("invalid rpc address format %s: %w", servers.RPC.Address, err)
+	}
+
+	// The address prefix is the one of the accounts created by the chain
+	signer, err := commands.ShowAccount(ctx, seedSigner(cfg))
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+	prefix, err := cosmosutil.GetAddressPrefix(signer.Address)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	options := []cosmosclient.Option{
+		cosmosclient.WithNodeAddress(rpcAddr),
+		cosmosclient.WithHome(home),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(keyringBackend)),
+		cosmosclient.WithAddressPrefix(prefix),
+		cosmosclient.WithGas(cosmosclient.GasAuto),
+	}
+
+	// Use the minimum gas prices of the node to pay the fees
+	if appConfig, err := toml.LoadFile(filepath.Join(home, "config/app.toml")); err == nil {
+		if gasPrices, ok := appConfig.Get("minimum-gas-prices").(string); ok && gasPrices != "" {
+			options = append(options, cosmosclient.WithGasPrices(gasPrices))
+		}
+	}
+
+	var client cosmosclient.Client
+	connect := func() (err error) {
+		client, err = cosmosclient.New(ctx, options...)
+		return err
+	}
+	err = backoff.Retry(connect, backoff.WithContext(backoff.NewConstantBackOff(seedRetryDelay), ctx))
+	return client, err
+}
+
+// seedSigner returns the name of an account that signs the seed transactions.
+func seedSigner(cfg *chainconfig.Config) string {
+	if len(cfg.Seed) > 0 {
+		return cfg.Seed[0].Signer
+	}
+	return cfg.Wasm.Contracts[0].Signer
+}
+
+// seedAddresses returns the addresses of the config accounts by name.
+func (c *Chain) seedAddresses(client cosmosclient.Client, cfg *chainconfig.Config) (map[string]string, error) {
+	var prefix string
+	addresses := make(map[string]string)
+	for _, account := range cfg.Accounts {
+		switch {
+		case account.Address != "":
+			addresses[account.Name] = account.Address
+		case account.Module == "":
+			address, err := client.Address(account.Name)
+			if err != nil {
+				return nil, err
+			}
+			addresses[account.Name] = address
+			prefix, _ = cosmosutil.GetAddressPrefix(address)
+		}
+	}
+
+	for _, account := range cfg.Accounts {
+		if account.Module == "" {
+			continue
+		}
+		address, err := moduleAccountAddress(account.Module, prefix)
+		if err != nil {
+			return nil, err
+		}
+		addresses[account.Name] = address
+	}
+	return addresses, nil
+}
+
+// broadcastSeedTx broadcasts the seed transaction and waits for its inclusion in a block.
+func broadcastSeedTx(
+	ctx context.Context,
+	client cosmosclient.Client,
+	files *protoregistry.Files,
+	tx chainconfig.SeedTx,
+	addresses map[string]string,
+) error {
+	body, err := seedTxBody(tx, addresses)
+	if err != nil {
+		return err
+	}
+
+	msg, err := cosmosclient.NewMsgFromJSON(files, tx.Type, body)
+	if err != nil {
+		return err
+	}
+
+	account, err := client.Account(tx.Signer)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.BroadcastTx(ctx, account, msg); err != nil {
+		return errors.Errorf("transaction rejected: %w", err)
+	}
+	return nil
+}
+
+// seedTxBody returns the JSON body of the seed transaction with
+// the ${name} placeholders replaced by the account addresses.
+func seedTxBody(tx chainconfig.SeedTx, addresses map[string]string) ([]byte, error) {
+	body, err := json.Marshal(tx.Body)
+	if err != nil {
+		return nil, err
+	}
+	return replaceSeedPlaceholders(body, addresses)
+}
+
+// replaceSeedPlaceholders replaces the ${name} placeholders of the JSON body by the addresses.
+func replaceSeedPlaceholders(body []byte, addresses map[string]string) ([]byte, error) {
+	var missing string
+	body = regexSeedPlaceholder.ReplaceAllFunc(body, func(placeholder []byte) []byte {
+		name := string(regexSeedPlaceholder.FindSubmatch(placeholder)[1])
+		address, ok := addresses[name]
+		if !ok {
+			missing = name
+			return placeholder
+		}
+		return []byte(address)
+	})
+	if missing != "" {
+		return nil, errors.Errorf("account %s not found in the config", missing)
+	}
+	return body, nil
+}
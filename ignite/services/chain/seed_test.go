@@ -0,0 +1,55 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+func TestSeedTxBody(t *testing.T) {
+	addresses := map[string]string{
+		"alice": "cosmos1alice",
+		"bob":   "cosmos1bob",
+	}
+
+	tests := []struct {
+		name string
+		body xyaml.Map
+		want string
+		err  string
+	}{
+		{
+			name: "body without placeholders",
+			body: xyaml.Map{"title": "hello"},
+			want: `{"title":"hello"}`,
+		},
+		{
+			name: "body with placeholders",
+			body: xyaml.Map{
+				"from_address": "${alice}",
+				"to_address":   "${bob}",
+				"amount":       []interface{}{map[string]interface{}{"denom": "token", "amount": "10"}},
+			},
+			want: `{"amount":[{"amount":"10","denom":"token"}],"from_address":"cosmos1alice","to_address":"cosmos1bob"}`,
+		},
+		{
+			name: "unknown account",
+			body: xyaml.Map{"creator": "${carol}"},
+			err:  "account carol not found in the config",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := seedTxBody(chainconfig.SeedTx{Body: tt.body}, addresses)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, tt.want, string(body))
+		})
+	}
+}
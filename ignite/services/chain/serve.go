@@ -72,7 +72,11 @@ type serveOptions struct {
 	skipBuild       bool
 	quitOnFail      bool
 	generateClients bool
+	validators      bool
+	cacheByHome     bool
 	buildTags       []string
+	stateInspector  *StateInspector
+	fork            *ForkArgs
 }
 
 func newServeOption() serveOptions {
@@ -82,6 +86,16 @@ func newServeOption() serveOptions {
 	}
 }
 
+// attempted updates the options after an attempt to serve the chain.
+// The state is only reset once and it is only forked once, when the fork succeeded,
+// so a failed attempt forks the state again on the next one.
+func (o *serveOptions) attempted(forked bool) {
+	o.resetOnce = false
+	if forked {
+		o.fork = nil
+	}
+}
+
 // ServeOption provides options for the serve command.
 type ServeOption func(*serveOptions)
 
@@ -128,6 +142,30 @@ func ServeSkipBuild() ServeOption {
 	}
 }
 
+// ServeValidators allows to serve a local network with a node for each validator defined in the config.
+func ServeValidators() ServeOption {
+	return func(c *serveOptions) {
+		c.validators = true
+	}
+}
+
+// ServeFork allows to serve a testnet created from the state of a network.
+// The state is forked once, replacing the state of the chain, and is then
+// kept like the state of a chain initialized from the config.
+func ServeFork(args ForkArgs) ServeOption {
+	return func(c *serveOptions) {
+		c.fork = &args
+	}
+}
+
+// ServeCacheByHome saves the checksums used to detect the changes of the chain by home,
+// to serve several chains with the same cache storage.
+func ServeCacheByHome() ServeOption {
+	return func(c *serveOptions) {
+		c.cacheByHome = true
+	}
+}
+
 // BuildTags set the build tags for the go build.
 func BuildTags(buildTags ...string) ServeOption {
 	return func(c *serveOptions) {
@@ -144,6 +182,8 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 		apply(&serveOptions)
 	}
 
+	c.stateInspector = serveOptions.stateInspector
+
 	// initial checks and setup.
 	if err := c.setup(); err != nil {
 		return err
@@ -158,6 +198,11 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 		return err
 	}
 
+	dirCache, err := c.serveDirCache(cacheStorage, serveOptions.cacheByHome)
+	if err != nil {
+		return err
+	}
+
 	// start serving components.
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -183,6 +228,7 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 				var (
 					serveCtx      context.Context
 					buildErr      *CannotBuildAppError
+					seedErr       *SeedError
 					startErr      *CannotStartAppError
 					validationErr *chainconfig.ValidationError
 				)
@@ -196,13 +242,16 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 				err = c.serve(
 					serveCtx,
 					cacheStorage,
+					dirCache,
 					serveOptions.buildTags,
 					shouldReset,
 					serveOptions.skipProto,
 					serveOptions.skipBuild,
 					serveOptions.generateClients,
+					serveOptions.validators,
+					serveOptions.fork,
 				)
-				serveOptions.resetOnce = false
+				serveOptions.attempted(c.forked)
 
 				switch {
 				case err == nil:
@@ -245,6 +294,12 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 						return err
 					}
 
+					c.ev.SendView(errorview.NewError(err), events.ProgressFinish(), events.Group(events.GroupError))
+				case errors.As(err, &seedErr):
+					if serveOptions.quitOnFail {
+						return err
+					}
+
 					c.ev.SendView(errorview.NewError(err), events.ProgressFinish(), events.Group(events.GroupError))
 				case errors.As(err, &startErr):
 					// Parse returned error logs
@@ -327,14 +382,31 @@ func (c *Chain) watchAppBackend(ctx context.Context) error {
 	)
 }
 
+// serveDirCache returns the cache of the checksums used to detect the changes of the chain.
+// The checksums are saved by home when several chains are served with the same cache storage,
+// otherwise the namespace of the previous versions is kept to reuse their checksums.
+func (c *Chain) serveDirCache(cacheStorage cache.Storage, byHome bool) (cache.Cache[[]byte], error) {
+	if !byHome {
+		return cache.New[[]byte](cacheStorage, serveDirchangeCacheNamespace), nil
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return cache.Cache[[]byte]{}, err
+	}
+	return cache.New[[]byte](cacheStorage, cache.Key(serveDirchangeCacheNamespace, ":", home)), nil
+}
+
 // serve performs the operations to serve the blockchain: build, init and start.
 // If the chain is already initialized and the file weren't changed, the app is directly started.
 // If the files changed, the state is imported.
 func (c *Chain) serve(
 	ctx context.Context,
 	cacheStorage cache.Storage,
+	dirCache cache.Cache[[]byte],
 	buildTags []string,
-	forceReset, skipProto, skipBuild, generateClients bool,
+	forceReset, skipProto, skipBuild, generateClients, validators bool,
+	fork *ForkArgs,
 ) error {
 	conf, err := c.Config()
 	if err != nil {
@@ -351,8 +423,6 @@ func (c *Chain) serve(
 	// isInit determines if the app is initialized
 	var isInit bool
 
-	dirCache := cache.New[[]byte](cacheStorage, serveDirchangeCacheNamespace)
-
 	// determine if the app must reset the state
 	// if the state must be reset, then we consider the chain as being not initialized
 	isInit, err = c.IsInitialized()
@@ -375,6 +445,11 @@ func (c *Chain) serve(
 		}
 	}
 
+	// the forked state replaces the state of the chain
+	if fork != nil {
+		isInit = false
+	}
+
 	// check if source has been modified since last serve
 	// if the state must not be reset but the source has changed, we rebuild the chain and import the exported state
 	sourceModified, err := dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, c.app.Path, sourceWatchPaths...)
@@ -436,7 +511,16 @@ func (c *Chain) serve(
 	if initApp {
 		c.ev.Send("Initializing the app...", events.ProgressUpdate())
 
-		if err := c.Init(ctx, InitArgsAll); err != nil {
+		if fork != nil {
+			if err := c.fork(ctx, commands, conf, *fork); err != nil {
+				return err
+			}
+			c.forked = true
+		} else if validators {
+			if err := c.InitValidators(ctx); err != nil {
+				return err
+			}
+		} else if err := c.Init(ctx, InitArgsAll); err != nil {
 			return err
 		}
 	} else if appModified {
@@ -451,6 +535,12 @@ func (c *Chain) serve(
 		if err := c.importChainState(); err != nil {
 			return err
 		}
+
+		if validators {
+			if err := c.ResetValidators(ctx); err != nil {
+				return err
+			}
+		}
 	} else {
 		c.ev.Send("Restarting existing app...", events.ProgressUpdate())
 	}
@@ -486,11 +576,11 @@ func (c *Chain) serve(
 		c.ev.SendView(view, events.ProgressFinish())
 	}
 
-	// start the blockchain
-	return c.start(ctx, conf)
+	// start the blockchain and seed its state when it was reset
+	return c.start(ctx, conf, validators, initApp)
 }
 
-func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
+func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config, validators, seed bool) error {
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return err
@@ -499,7 +589,11 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 	g, ctx := errgroup.WithContext(ctx)
 
 	// start the blockchain.
-	g.Go(func() error { return c.Start(ctx, commands, cfg) })
+	if validators {
+		g.Go(func() error { return c.startValidators(ctx, cfg) })
+	} else {
+		g.Go(func() error { return c.Start(ctx, commands, cfg) })
+	}
 
 	// start the faucet if enabled.
 	faucet, err := c.Faucet(ctx)
@@ -521,6 +615,16 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 		})
 	}
 
+	// deploy the wasm contracts and broadcast the seed transactions once the chain is started.
+	if seed && hasSeed(cfg) {
+		g.Go(func() error {
+			if err := c.seed(ctx, cfg); err != nil {
+				return &SeedError{err}
+			}
+			return nil
+		})
+	}
+
 	// set the app as being served
 	c.served = true
 
@@ -540,6 +644,15 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 	rpcAddr, _ := xurl.HTTP(servers.RPC.Address)
 	apiAddr, _ := xurl.HTTP(servers.API.Address)
 
+	// inspect the state of the started node.
+	if c.stateInspector != nil {
+		options, err := c.stateInspectorOptions(rpcAddr)
+		if err != nil {
+			return err
+		}
+		c.stateInspector.reset(options...)
+	}
+
 	c.ev.Send(
 		fmt.Sprintf("Tendermint node: %s", rpcAddr),
 		events.Icon(icons.Earth),
@@ -559,6 +672,25 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 		)
 	}
 
+	if validators {
+		homes, err := c.ValidatorHomes()
+		if err != nil {
+			return err
+		}
+		for i, v := range cfg.Validators[1:] {
+			servers, err := v.GetServers()
+			if err != nil {
+				return err
+			}
+			rpcAddr, _ := xurl.HTTP(servers.RPC.Address)
+
+			c.ev.Send(
+				fmt.Sprintf("Validator %s node: %s (%s)", v.Name, rpcAddr, colors.Faint(homes[i+1])),
+				events.Icon(icons.Earth),
+			)
+		}
+	}
+
 	appHome, _ := c.Home()
 	appBin, _ := c.AbsBinaryPath()
 
@@ -663,6 +795,19 @@ func (e *CannotBuildAppError) Unwrap() error {
 	return e.Err
 }
 
+// SeedError is returned when the seed transactions of the config can't be broadcasted.
+type SeedError struct {
+	Err error
+}
+
+func (e *SeedError) Error() string {
+	return fmt.Sprintf("cannot seed the app state:\n\n%s", e.Err)
+}
+
+func (e *SeedError) Unwrap() error {
+	return e.Err
+}
+
 type CannotStartAppError struct {
 	AppName string
 	Err     error
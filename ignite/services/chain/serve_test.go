@@ -0,0 +1,55 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/dirchange"
+)
+
+func TestServeDirCache(t *testing.T) {
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+
+	appPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "app.go"), []byte("package app"), 0o644))
+
+	// checksum saved by a previous serve with the cache namespace of a single chain
+	oldCache := cache.New[[]byte](storage, serveDirchangeCacheNamespace)
+	require.NoError(t, dirchange.SaveDirChecksum(oldCache, sourceChecksumKey, appPath, "app.go"))
+
+	c := &Chain{}
+	c.options.homePath = t.TempDir()
+
+	dirCache, err := c.serveDirCache(storage, false)
+	require.NoError(t, err)
+	changed, err := dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, appPath, "app.go")
+	require.NoError(t, err)
+	require.False(t, changed, "the checksum saved with the single chain namespace must be honored")
+
+	dirCache, err = c.serveDirCache(storage, true)
+	require.NoError(t, err)
+	changed, err = dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, appPath, "app.go")
+	require.NoError(t, err)
+	require.True(t, changed, "the checksums saved by home must not share the single chain namespace")
+}
+
+func TestServeOptionsAttempted(t *testing.T) {
+	serveOptions := newServeOption()
+	for _, apply := range []ServeOption{ServeResetOnce(), ServeFork(ForkArgs{Genesis: "genesis.json"})} {
+		apply(&serveOptions)
+	}
+
+	// the first attempt fails before the fork succeeded
+	serveOptions.attempted(false)
+	require.False(t, serveOptions.resetOnce)
+	require.NotNil(t, serveOptions.fork, "the retry must fork the state")
+
+	// the retry forks the state
+	serveOptions.attempted(true)
+	require.Nil(t, serveOptions.fork, "the state must only be forked once")
+}
@@ -1,9 +1,24 @@
 package chain
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/otiai10/copy"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/cosmos/cosmos-sdk/types/simulation"
+
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
 )
 
 type simappOptions struct {
@@ -11,6 +26,7 @@ type simappOptions struct {
 	enabled            bool
 	config             simulation.Config
 	genesisTime        int64
+	parallel           int
 }
 
 func newSimappOptions() simappOptions {
@@ -40,6 +56,14 @@ func SimappWithConfig(config simulation.Config) SimappOption {
 	}
 }
 
+// SimappWithParallel sets the maximum number of simulations run concurrently by SimulateSeeds.
+// The default is the number of CPUs.
+func SimappWithParallel(parallel int) SimappOption {
+	return func(c *simappOptions) {
+		c.parallel = parallel
+	}
+}
+
 // SimappWithSimulationTestName allows to set the simulation test name.
 func SimappWithSimulationTestName(name string) SimappOption {
 	return func(c *simappOptions) {
@@ -67,3 +91,312 @@ func (c *Chain) Simulate(ctx context.Context, options ...SimappOption) error {
 		simappOptions.genesisTime,
 	)
 }
+
+// SimulationSummary is the summary of the simulations of several seeds.
+type SimulationSummary struct {
+	// Simulation is the name of the simulation test.
+	Simulation string `json:"simulation"`
+
+	// Passed is the number of seeds with a successful simulation.
+	Passed int `json:"passed"`
+
+	// Failed is the number of seeds with a failed simulation.
+	Failed int `json:"failed"`
+
+	// Results are the results of the simulation of each seed.
+	Results []SimulationResult `json:"results"`
+
+	// Dir is the directory where the summary and the reproduction bundles are written.
+	Dir string `json:"-"`
+}
+
+// SimulationResult is the result of the simulation of a seed.
+type SimulationResult struct {
+	// Seed is the random seed of the simulation.
+	Seed int64 `json:"seed"`
+
+	// Passed indicates that the simulation is successful.
+	Passed bool `json:"passed"`
+
+	// Duration is the duration of the simulation.
+	Duration string `json:"duration"`
+
+	// Error is the end of the output of a failed simulation.
+	Error string `json:"error,omitempty"`
+
+	// Stats are the operation statistics exported by the simulation.
+	Stats json.RawMessage `json:"stats,omitempty"`
+
+	// ExportedParams is the params file exported by the simulation.
+	ExportedParams string `json:"exported_params,omitempty"`
+
+	// ExportedState is the app state file exported by the simulation.
+	ExportedState string `json:"exported_state,omitempty"`
+
+	// ReproductionDir is the directory of the reproduction bundle of a failed simulation.
+	ReproductionDir string `json:"reproduction_dir,omitempty"`
+}
+
+// simulationReproduction describes how to reproduce a failed simulation.
+type simulationReproduction struct {
+	Seed        int64             `json:"seed"`
+	Simulation  string            `json:"simulation"`
+	GenesisTime int64             `json:"genesis_time"`
+	Config      simulation.Config `json:"config"`
+	Workdir     string            `json:"workdir"`
+	Command     string            `json:"command"`
+}
+
+const (
+	// simulationsDir is the directory of the app where the multi-seed simulation runs are written.
+	simulationsDir = "simulations"
+
+	simulationSummaryFile      = "summary.json"
+	simulationReproductionFile = "reproduce.json"
+	simulationOutputFile       = "output.log"
+	simulationStatsFile        = "stats.json"
+	simulationGenesisFile      = "genesis.json"
+	simulationParamsFile       = "params.json"
+	simulationExportParamsFile = "exported_params.json"
+	simulationExportStateFile  = "exported_state.json"
+
+	// simulationErrorLines is the number of output lines of a failed simulation kept in the summary.
+	simulationErrorLines = 10
+)
+
+// SimulateSeeds runs the simulation for each seed concurrently, with at most one simulation per CPU
+// by default. The pass or fail result and the operation statistics of each seed are aggregated in a
+// summary written with a reproduction bundle for each failed seed in a new directory of the app. A
+// bundle contains the output of the simulation, the command to reproduce it and the genesis and the
+// params files used by the simulation. When the params or the state are exported, each seed exports
+// them in the directory of its bundle.
+func (c *Chain) SimulateSeeds(ctx context.Context, seeds []int64, options ...SimappOption) (SimulationSummary, error) {
+	simappOptions := newSimappOptions()
+	for _, apply := range options {
+		apply(&simappOptions)
+	}
+
+	config := simappOptions.config
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return SimulationSummary{}, err
+	}
+
+	runDir := filepath.Join(c.app.Path, simulationsDir, time.Now().Format("20060102-150405"))
+	if err := os.MkdirAll(runDir, 0o755); err != nil {
+		return SimulationSummary{}, err
+	}
+
+	statsDir, err := os.MkdirTemp("", "simulation-stats")
+	if err != nil {
+		return SimulationSummary{}, err
+	}
+	defer os.RemoveAll(statsDir)
+
+	parallel := simappOptions.parallel
+	if parallel <= 0 {
+		parallel = runtime.NumCPU()
+	}
+
+	var (
+		results = make([]SimulationResult, len(seeds))
+		g, gCtx = errgroup.WithContext(ctx)
+	)
+	g.SetLimit(parallel)
+	for i, seed := range seeds {
+		g.Go(func() error {
+			seedConfig := config
+			seedConfig.Seed = seed
+			seedConfig.ExportStatsPath = filepath.Join(statsDir, fmt.Sprintf("%d.json", seed))
+			seedConfig, err := seedExportConfig(runDir, seedConfig)
+			if err != nil {
+				return err
+			}
+
+			var (
+				output bytes.Buffer
+				start  = time.Now()
+			)
+			runErr := commands.SimulationOutput(
+				gCtx,
+				&output,
+				c.app.Path,
+				simappOptions.simulationTestName,
+				simappOptions.enabled,
+				seedConfig,
+				simappOptions.genesisTime,
+			)
+			if gCtx.Err() != nil {
+				return gCtx.Err()
+			}
+
+			result := SimulationResult{
+				Seed:     seed,
+				Passed:   runErr == nil,
+				Duration: time.Since(start).Round(time.Second).String(),
+			}
+			if stats, err := os.ReadFile(seedConfig.ExportStatsPath); err == nil && json.Valid(stats) {
+				result.Stats = stats
+			}
+			if _, err := os.Stat(seedConfig.ExportParamsPath); err == nil {
+				result.ExportedParams = seedConfig.ExportParamsPath
+			}
+			if _, err := os.Stat(seedConfig.ExportStatePath); err == nil {
+				result.ExportedState = seedConfig.ExportStatePath
+			}
+
+			if runErr != nil {
+				result.Error = lastLines(output.String(), simulationErrorLines)
+				result.ReproductionDir, err = writeSimulationReproduction(
+					runDir,
+					c.app.Path,
+					simappOptions,
+					seedConfig,
+					output.Bytes(),
+					result.Stats,
+				)
+				if err != nil {
+					return err
+				}
+				c.ev.Send(
+					fmt.Sprintf("Seed %d failed (%s): %s", seed, result.Duration, result.ReproductionDir),
+					events.Icon(icons.NotOK),
+				)
+			} else {
+				c.ev.Send(
+					fmt.Sprintf("Seed %d passed (%s)", seed, result.Duration),
+					events.Icon(icons.OK),
+				)
+			}
+
+			results[i] = result
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return SimulationSummary{}, err
+	}
+
+	summary := SimulationSummary{
+		Simulation: simappOptions.simulationTestName,
+		Results:    results,
+		Dir:        runDir,
+	}
+	for _, r := range results {
+		if r.Passed {
+			summary.Passed++
+		} else {
+			summary.Failed++
+		}
+	}
+
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return SimulationSummary{}, err
+	}
+	if err := os.WriteFile(filepath.Join(runDir, simulationSummaryFile), data, 0o644); err != nil {
+		return SimulationSummary{}, err
+	}
+	return summary, nil
+}
+
+// seedDir returns the directory of the files of a seed in the directory of the run.
+func seedDir(runDir string, seed int64) string {
+	return filepath.Join(runDir, fmt.Sprintf("seed-%d", seed))
+}
+
+// seedExportConfig sets the export paths of the params and the state requested by the config
+// to files in the directory of the seed, so the seeds don't overwrite the exports of each other.
+func seedExportConfig(runDir string, config simulation.Config) (simulation.Config, error) {
+	if config.ExportParamsPath == "" && config.ExportStatePath == "" {
+		return config, nil
+	}
+
+	dir := seedDir(runDir, config.Seed)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return config, err
+	}
+	if config.ExportParamsPath != "" {
+		config.ExportParamsPath = filepath.Join(dir, simulationExportParamsFile)
+	}
+	if config.ExportStatePath != "" {
+		config.ExportStatePath = filepath.Join(dir, simulationExportStateFile)
+	}
+	return config, nil
+}
+
+// writeSimulationReproduction writes the reproduction bundle of a failed simulation and returns its directory.
+// The genesis and the params files of the simulation are copied in the bundle to be used by the command.
+func writeSimulationReproduction(
+	runDir, appPath string,
+	options simappOptions,
+	config simulation.Config,
+	output []byte,
+	stats []byte,
+) (string, error) {
+	dir := seedDir(runDir, config.Seed)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	// The statistics are printed when reproducing the simulation
+	config.ExportStatsPath = ""
+
+	if config.GenesisFile != "" {
+		path := filepath.Join(dir, simulationGenesisFile)
+		if err := copy.Copy(config.GenesisFile, path); err != nil {
+			return "", err
+		}
+		config.GenesisFile = path
+	}
+	if config.ParamsFile != "" {
+		path := filepath.Join(dir, simulationParamsFile)
+		if err := copy.Copy(config.ParamsFile, path); err != nil {
+			return "", err
+		}
+		config.ParamsFile = path
+	}
+
+	reproduction := simulationReproduction{
+		Seed:        config.Seed,
+		Simulation:  options.simulationTestName,
+		GenesisTime: options.genesisTime,
+		Config:      config,
+		Workdir:     appPath,
+		Command: chaincmdrunner.SimulationCommandLine(
+			appPath,
+			options.simulationTestName,
+			options.enabled,
+			config,
+			options.genesisTime,
+		),
+	}
+	data, err := json.MarshalIndent(reproduction, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	files := map[string][]byte{
+		simulationReproductionFile: data,
+		simulationOutputFile:       output,
+	}
+	if len(stats) > 0 {
+		files[simulationStatsFile] = stats
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0o644); err != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+// lastLines returns the last non-empty lines of a text.
+func lastLines(text string, n int) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,107 @@
+package chain
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestWriteSimulationReproduction(t *testing.T) {
+	var (
+		runDir  = t.TempDir()
+		appPath = t.TempDir()
+		genesis = filepath.Join(t.TempDir(), "genesis.json")
+	)
+	require.NoError(t, os.WriteFile(genesis, []byte(`{"app_state":{}}`), 0o600))
+
+	options := newSimappOptions()
+	options.simulationTestName = "TestFullAppSimulation"
+	options.genesisTime = 1700000000
+
+	config := options.config
+	config.Seed = 7
+	config.NumBlocks = 50
+	config.BlockSize = 10
+	config.GenesisFile = genesis
+	config.ExportStatsPath = filepath.Join(t.TempDir(), "stats.json")
+
+	dir, err := writeSimulationReproduction(runDir, appPath, options, config, []byte("--- FAIL"), []byte(`{"bank":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(runDir, "seed-7"), dir)
+
+	output, err := os.ReadFile(filepath.Join(dir, simulationOutputFile))
+	require.NoError(t, err)
+	require.Equal(t, "--- FAIL", string(output))
+
+	stats, err := os.ReadFile(filepath.Join(dir, simulationStatsFile))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"bank":{}}`, string(stats))
+
+	// The genesis of the simulation is copied in the bundle
+	bundleGenesis := filepath.Join(dir, simulationGenesisFile)
+	require.FileExists(t, bundleGenesis)
+
+	data, err := os.ReadFile(filepath.Join(dir, simulationReproductionFile))
+	require.NoError(t, err)
+
+	var reproduction simulationReproduction
+	require.NoError(t, json.Unmarshal(data, &reproduction))
+	require.Equal(t, int64(7), reproduction.Seed)
+	require.Equal(t, appPath, reproduction.Workdir)
+	require.Equal(t, simulation.Config{
+		Commit:      true,
+		Seed:        7,
+		NumBlocks:   50,
+		BlockSize:   10,
+		GenesisFile: bundleGenesis,
+	}, reproduction.Config)
+
+	for _, arg := range []string{
+		"-run=^TestFullAppSimulation$",
+		"-Seed 7",
+		"-NumBlocks 50",
+		"-Genesis " + bundleGenesis,
+		"-GenesisTime 1700000000",
+	} {
+		require.Contains(t, reproduction.Command, arg)
+	}
+	require.NotContains(t, reproduction.Command, "-ExportStatsPath")
+}
+
+func TestSeedExportConfig(t *testing.T) {
+	runDir := t.TempDir()
+
+	options := newSimappOptions()
+	config := options.config
+	config.Seed = 3
+
+	// The config is unchanged when nothing is exported
+	got, err := seedExportConfig(runDir, config)
+	require.NoError(t, err)
+	require.Equal(t, config, got)
+	require.NoDirExists(t, filepath.Join(runDir, "seed-3"))
+
+	config.ExportParamsPath = "params.json"
+	config.ExportStatePath = "state.json"
+	got, err = seedExportConfig(runDir, config)
+	require.NoError(t, err)
+	require.DirExists(t, filepath.Join(runDir, "seed-3"))
+	require.Equal(t, filepath.Join(runDir, "seed-3", simulationExportParamsFile), got.ExportParamsPath)
+	require.Equal(t, filepath.Join(runDir, "seed-3", simulationExportStateFile), got.ExportStatePath)
+
+	// Only the requested exports are set
+	config.ExportStatePath = ""
+	got, err = seedExportConfig(runDir, config)
+	require.NoError(t, err)
+	require.Empty(t, got.ExportStatePath)
+}
+
+func TestLastLines(t *testing.T) {
+	require.Equal(t, "c\nd", lastLines("a\nb\nc\nd\n\n", 2))
+	require.Equal(t, "a\nb", lastLines("a\nb", 5))
+}
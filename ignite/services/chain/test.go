@@ -0,0 +1,283 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+	"github.com/ignite/cli/v29/ignite/pkg/gotest"
+)
+
+// TestSuite is a suite of tests of the chain.
+type TestSuite string
+
+const (
+	// TestSuiteUnit is the suite of the tests of the packages of the app,
+	// except the integration test packages.
+	TestSuiteUnit TestSuite = "unit"
+
+	// TestSuiteIntegration is the suite of the tests of the integration test
+	// packages of the app, like the ones scaffolded in "x/{module}/integration".
+	TestSuiteIntegration TestSuite = "integration"
+
+	// TestSuiteSimulation is the suite of the simulation tests of the app package.
+	TestSuiteSimulation TestSuite = "simulation"
+)
+
+// TestSuites are the test suites of the chain in their running order.
+var TestSuites = []TestSuite{TestSuiteUnit, TestSuiteIntegration, TestSuiteSimulation}
+
+// integrationDir is the directory name of the integration test packages.
+const integrationDir = "integration"
+
+type testOptions struct {
+	suites       []TestSuite
+	modules      []string
+	simulation   simulation.Config
+	coverProfile string
+}
+
+// TestOption configures the tests of the chain.
+type TestOption func(*testOptions)
+
+// TestWithSuites sets the test suites to run, all the suites are run by default.
+func TestWithSuites(suites ...TestSuite) TestOption {
+	return func(o *testOptions) {
+		o.suites = suites
+	}
+}
+
+// TestWithModules restricts the unit and integration tests and the coverage to the given modules.
+// The simulation tests always run the whole app.
+func TestWithModules(modules ...string) TestOption {
+	return func(o *testOptions) {
+		o.modules = modules
+	}
+}
+
+// TestWithSimulationConfig sets the seed, the number of blocks and the block size of the simulation tests.
+func TestWithSimulationConfig(config simulation.Config) TestOption {
+	return func(o *testOptions) {
+		o.simulation = config
+	}
+}
+
+// TestWithCoverProfile writes the coverage profile of all the suites to the file.
+func TestWithCoverProfile(path string) TestOption {
+	return func(o *testOptions) {
+		o.coverProfile = path
+	}
+}
+
+// TestSuiteReport is the result of the tests of a suite.
+type TestSuiteReport struct {
+	gotest.Report
+
+	Suite TestSuite
+}
+
+// TestReport is the result of the tests of the chain.
+type TestReport struct {
+	// Suites are the results of the test suites that were run.
+	Suites []TestSuiteReport
+
+	// Coverage is the statement coverage of each module of the app by all the suites.
+	Coverage map[string]gotest.Coverage
+}
+
+// Failed returns true when a test suite failed.
+func (r TestReport) Failed() bool {
+	for _, s := range r.Suites {
+		if s.Failed() {
+			return true
+		}
+	}
+	return false
+}
+
+// Report returns the results of the packages of all the suites.
+func (r TestReport) Report() gotest.Report {
+	var report gotest.Report
+	for _, s := range r.Suites {
+		report.Packages = append(report.Packages, s.Packages...)
+	}
+	return report
+}
+
+// Test runs the test suites of the chain and collects the coverage of its modules.
+// A failing test doesn't return an error, the failures are reported in the test report.
+func (c *Chain) Test(ctx context.Context, options ...TestOption) (TestReport, error) {
+	o := testOptions{
+		suites: TestSuites,
+		simulation: simulation.Config{
+			Seed:      42,
+			NumBlocks: 50,
+			BlockSize: 20,
+		},
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	packages, err := gocmd.List(ctx, c.app.Path, []string{"./..."})
+	if err != nil {
+		return TestReport{}, err
+	}
+
+	if err := c.checkModules(packages, o.modules); err != nil {
+		return TestReport{}, err
+	}
+	isSelected := func(pkg string) bool {
+		return len(o.modules) == 0 || slices.Contains(o.modules, c.moduleOf(pkg))
+	}
+
+	var unitPackages, integrationPackages, coverPackages []string
+	for _, pkg := range packages {
+		if !isSelected(pkg) {
+			continue
+		}
+		if c.moduleOf(pkg) != "" {
+			coverPackages = append(coverPackages, pkg)
+		}
+		if slices.Contains(strings.Split(strings.TrimPrefix(pkg, c.app.ImportPath), "/"), integrationDir) {
+			integrationPackages = append(integrationPackages, pkg)
+		} else {
+			unitPackages = append(unitPackages, pkg)
+		}
+	}
+
+	tmpDir, err := os.MkdirTemp("", "ignite-chain-test")
+	if err != nil {
+		return TestReport{}, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var (
+		report   TestReport
+		profiles []string
+	)
+	for _, suite := range o.suites {
+		var args []string
+		switch suite {
+		case TestSuiteUnit:
+			args = unitPackages
+		case TestSuiteIntegration:
+			args = integrationPackages
+		case TestSuiteSimulation:
+			args = append([]string{"-tags=sims"}, c.app.ImportPath+"/app")
+			for _, apply := range []chaincmd.SimappOption{
+				chaincmd.SimappWithEnable(true),
+				chaincmd.SimappWithCommit(true),
+				chaincmd.SimappWithSeed(o.simulation.Seed),
+				chaincmd.SimappWithNumBlocks(uint64(o.simulation.NumBlocks)),
+				chaincmd.SimappWithBlockSize(o.simulation.BlockSize),
+			} {
+				args = apply(args)
+			}
+		default:
+			return TestReport{}, errors.Errorf("unknown test suite %s", suite)
+		}
+
+		// Skip the suites without packages, like the integration tests of an app without integration test packages
+		if len(args) == 0 {
+			report.Suites = append(report.Suites, TestSuiteReport{Suite: suite})
+			continue
+		}
+
+		c.ev.Send(fmt.Sprintf("Running the %s tests...", suite), events.ProgressUpdate())
+
+		profile := filepath.Join(tmpDir, fmt.Sprintf("%s.cover", suite))
+		flags := []string{"-json", "-coverprofile=" + profile}
+		if len(coverPackages) > 0 {
+			flags = append(flags, "-coverpkg="+strings.Join(coverPackages, ","))
+		}
+
+		suiteReport, err := c.runTests(ctx, append(flags, args...))
+		if err != nil {
+			return TestReport{}, errors.Wrapf(err, "%s tests", suite)
+		}
+		report.Suites = append(report.Suites, TestSuiteReport{Report: suiteReport, Suite: suite})
+
+		if _, err := os.Stat(profile); err == nil {
+			profiles = append(profiles, profile)
+		}
+	}
+
+	coverProfile := o.coverProfile
+	if coverProfile == "" {
+		coverProfile = filepath.Join(tmpDir, "coverage.out")
+	}
+	f, err := os.Create(coverProfile)
+	if err != nil {
+		return TestReport{}, err
+	}
+	defer f.Close()
+
+	merged, err := gotest.MergeProfiles(f, profiles...)
+	if err != nil {
+		return TestReport{}, err
+	}
+	report.Coverage = gotest.CoverageBy(merged, func(file string) string {
+		return c.moduleOf(path.Dir(file))
+	})
+	// Report the selected modules even when no suite collected their coverage
+	for _, pkg := range coverPackages {
+		if _, ok := report.Coverage[c.moduleOf(pkg)]; !ok {
+			report.Coverage[c.moduleOf(pkg)] = gotest.Coverage{}
+		}
+	}
+
+	return report, nil
+}
+
+// moduleOf returns the name of the module of the package, or an empty
+// string when the package isn't in the "x" directory of the app.
+func (c *Chain) moduleOf(pkg string) string {
+	path, ok := strings.CutPrefix(pkg, c.app.ImportPath+"/x/")
+	if !ok {
+		return ""
+	}
+	name, _, _ := strings.Cut(path, "/")
+	return name
+}
+
+// checkModules checks that the modules have packages in the "x" directory of the app.
+func (c *Chain) checkModules(packages, modules []string) error {
+	for _, m := range modules {
+		if !slices.ContainsFunc(packages, func(pkg string) bool { return c.moduleOf(pkg) == m }) {
+			return errors.Errorf("module %s not found in %s", m, filepath.Join(c.app.Path, "x"))
+		}
+	}
+	return nil
+}
+
+// runTests runs go test with the JSON output and parses its results.
+func (c *Chain) runTests(ctx context.Context, flags []string) (gotest.Report, error) {
+	var stdout bytes.Buffer
+	err := gocmd.Test(ctx, c.app.Path, flags, exec.StepOption(step.Stdout(&stdout)))
+
+	report, parseErr := gotest.Parse(&stdout)
+	if parseErr != nil {
+		return gotest.Report{}, parseErr
+	}
+
+	// go test fails when a test fails, the error is only returned when
+	// no test failed, like when the arguments of go test are invalid.
+	if err != nil && !report.Failed() {
+		return gotest.Report{}, err
+	}
+	return report, nil
+}
@@ -0,0 +1,264 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+)
+
+const (
+	composeFile    = "docker-compose.yml"
+	dockerfile     = "Dockerfile"
+	exportBinDir   = "bin"
+	containerOS    = "linux"
+	containerImage = "debian:bookworm-slim"
+
+	// Ports used by the nodes inside the containers.
+	containerPortP2P        = "26656"
+	containerPortRPC        = "26657"
+	containerPortProxyApp   = "26658"
+	containerPortPrometheus = "26660"
+	containerPortAPI        = "1317"
+	containerPortGRPC       = "9090"
+)
+
+type (
+	// composeProject is a Docker Compose project.
+	composeProject struct {
+		Services map[string]composeService `yaml:"services"`
+	}
+
+	// composeService is a service of a Docker Compose project.
+	composeService struct {
+		Build         string   `yaml:"build,omitempty"`
+		Image         string   `yaml:"image"`
+		ContainerName string   `yaml:"container_name"`
+		Command       []string `yaml:"command"`
+		Volumes       []string `yaml:"volumes"`
+		Ports         []string `yaml:"ports"`
+		DependsOn     []string `yaml:"depends_on,omitempty"`
+	}
+
+	// exportedNode is a node of the exported testnet.
+	exportedNode struct {
+		name  string
+		home  string
+		ports map[string]string // container port by host port
+	}
+)
+
+// ExportMultiNode exports the multi-node testnet as a Docker Compose project in the output directory.
+// The project contains the home of each node, configured to run in its own container, the app
+// binary built for Linux and a Dockerfile to build the image of the nodes.
+// The testnet can then be started anywhere with "docker compose up".
+func (c *Chain) ExportMultiNode(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	args MultiNodeArgs,
+	skipProto bool,
+) error {
+	binary, err := c.BuildTarget(
+		ctx,
+		cacheStorage,
+		nil,
+		filepath.Join(args.OutputDir, exportBinDir),
+		gocmd.BuildTarget(containerOS, runtime.GOARCH),
+		skipProto,
+	)
+	if err != nil {
+		return err
+	}
+
+	nodes := make([]exportedNode, len(args.ListPorts))
+	for i := range nodes {
+		name := fmt.Sprintf("%s%d", args.NodeDirPrefix, i)
+		nodes[i] = exportedNode{
+			name: name,
+			home: filepath.Join(args.OutputDir, name),
+		}
+
+		// The node homes are always initialized from the beginning
+		if err := os.RemoveAll(nodes[i].home); err != nil {
+			return err
+		}
+	}
+
+	c.ev.Send("Initializing the node homes...", events.ProgressUpdate())
+
+	if err := c.TestnetMultiNode(ctx, args); err != nil {
+		return err
+	}
+
+	for i := range nodes {
+		if nodes[i].ports, err = configureContainerNode(nodes[i].home, nodes); err != nil {
+			return errors.Errorf("node %s: %w", nodes[i].name, err)
+		}
+	}
+
+	if err := writeDockerfile(args.OutputDir, binary); err != nil {
+		return err
+	}
+
+	return writeComposeFile(args.OutputDir, c.Name(), binary, nodes)
+}
+
+// configureContainerNode updates the config of the node home to run inside a container where the
+// other nodes are reachable by their names. It returns the ports to publish on the host, using the
+// ports initially configured for the node so the testnet keeps the same addresses.
+func configureContainerNode(home string, nodes []exportedNode) (map[string]string, error) {
+	ports := make(map[string]string)
+
+	configPath := filepath.Join(home, "config/config.toml")
+	config, err := toml.LoadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, containerPort := range map[string]string{
+		"p2p.laddr": containerPortP2P,
+		"rpc.laddr": containerPortRPC,
+	} {
+		port, err := addressPort(config.Get(key))
+		if err != nil {
+			return nil, errors.Errorf("invalid %s: %w", key, err)
+		}
+		ports[port] = containerPort
+		config.Set(key, "tcp://0.0.0.0:"+containerPort)
+	}
+
+	// The nodes are connected using their container names.
+	// Persistent peers are listed in the same order as the nodes.
+	peers := strings.Split(fmt.Sprint(config.Get("p2p.persistent_peers")), ",")
+	if len(peers) != len(nodes) {
+		return nil, errors.Errorf("expected %d persistent peers, got %d", len(nodes), len(peers))
+	}
+	var nodePeers []string
+	for i, peer := range peers {
+		nodeID, _, _ := strings.Cut(peer, "@")
+		if nodes[i].home == home {
+			continue
+		}
+		nodePeers = append(nodePeers, fmt.Sprintf("%s@%s:%s", nodeID, nodes[i].name, containerPortP2P))
+	}
+	config.Set("p2p.persistent_peers", strings.Join(nodePeers, ","))
+	config.Set("proxy_app", "tcp://127.0.0.1:"+containerPortProxyApp)
+	config.Set("instrumentation.prometheus_listen_addr", ":"+containerPortPrometheus)
+
+	if err := os.WriteFile(configPath, []byte(config.String()), 0o644); err != nil {
+		return nil, err
+	}
+
+	appPath := filepath.Join(home, "config/app.toml")
+	app, err := toml.LoadFile(appPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, containerPort := range map[string]string{
+		"api.address":  containerPortAPI,
+		"grpc.address": containerPortGRPC,
+	} {
+		port, err := addressPort(app.Get(key))
+		if err != nil {
+			return nil, errors.Errorf("invalid %s: %w", key, err)
+		}
+		ports[port] = containerPort
+	}
+	app.Set("api.address", "tcp://0.0.0.0:"+containerPortAPI)
+	app.Set("grpc.address", "0.0.0.0:"+containerPortGRPC)
+
+	return ports, os.WriteFile(appPath, []byte(app.String()), 0o644)
+}
+
+// writeDockerfile writes the Dockerfile of the image running the app binary.
+func writeDockerfile(dir, binary string) error {
+	content := fmt.Sprintf(`FROM %[1]s
+COPY %[2]s/%[3]s /usr/local/bin/%[3]s
+ENTRYPOINT ["%[3]s"]
+`, containerImage, exportBinDir, binary)
+
+	return os.WriteFile(filepath.Join(dir, dockerfile), []byte(content), 0o644)
+}
+
+// writeComposeFile writes the Docker Compose file with a service for each node.
+func writeComposeFile(dir, appName, binary string, nodes []exportedNode) error {
+	var (
+		image   = appName + "-node"
+		home    = "/root/." + appName
+		project = composeProject{Services: make(map[string]composeService)}
+	)
+
+	for i, node := range nodes {
+		s := composeService{
+			Image:         image,
+			ContainerName: fmt.Sprintf("%s-%s", appName, node.name),
+			Command:       []string{"start", "--home", home},
+			Volumes:       []string{fmt.Sprintf("./%s:%s", node.name, home)},
+			Ports:         publishedPorts(node.ports),
+		}
+
+		// The image is built by the first service and used by the other ones
+		if i == 0 {
+			s.Build = "."
+		} else {
+			s.DependsOn = []string{nodes[0].name}
+		}
+
+		project.Services[node.name] = s
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "# Generated by Ignite, the nodes run the %s binary.\n", binary)
+
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(project); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, composeFile), out.Bytes(), 0o644)
+}
+
+// publishedPorts returns the host to container port mappings sorted by container port.
+func publishedPorts(ports map[string]string) []string {
+	var published []string
+	for _, containerPort := range []string{
+		containerPortP2P,
+		containerPortRPC,
+		containerPortAPI,
+		containerPortGRPC,
+	} {
+		for hostPort, p := range ports {
+			if p == containerPort {
+				published = append(published, fmt.Sprintf("%s:%s", hostPort, containerPort))
+			}
+		}
+	}
+	return published
+}
+
+// addressPort returns the port of a node address, with or without scheme.
+func addressPort(address interface{}) (string, error) {
+	addr, ok := address.(string)
+	if !ok {
+		return "", errors.Errorf("invalid address %v", address)
+	}
+	if _, after, found := strings.Cut(addr, "://"); found {
+		addr = after
+	}
+	_, port, err := net.SplitHostPort(addr)
+	return port, err
+}
@@ -0,0 +1,55 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureContainerNode(t *testing.T) {
+	dir := t.TempDir()
+	nodes := []exportedNode{
+		{name: "validator0", home: filepath.Join(dir, "validator0")},
+		{name: "validator1", home: filepath.Join(dir, "validator1")},
+	}
+
+	for i, node := range nodes {
+		require.NoError(t, os.MkdirAll(filepath.Join(node.home, "config"), 0o755))
+
+		config := `proxy_app = "tcp://127.0.0.1:26658"
+
+[rpc]
+laddr = "tcp://127.0.0.1:2665` + []string{"7", "4"}[i] + `"
+
+[p2p]
+laddr = "tcp://0.0.0.0:2665` + []string{"6", "3"}[i] + `"
+persistent_peers = "id0@localhost:26656,id1@localhost:26653"
+`
+		app := `[api]
+address = "tcp://localhost:131` + []string{"7", "6"}[i] + `"
+
+[grpc]
+address = "localhost:90` + []string{"90", "88"}[i] + `"
+`
+		require.NoError(t, os.WriteFile(filepath.Join(node.home, "config/config.toml"), []byte(config), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(node.home, "config/app.toml"), []byte(app), 0o644))
+	}
+
+	ports, err := configureContainerNode(nodes[1].home, nodes)
+	require.NoError(t, err)
+	require.Equal(t, []string{"26653:26656", "26654:26657", "1316:1317", "9088:9090"}, publishedPorts(ports))
+
+	config, err := toml.LoadFile(filepath.Join(nodes[1].home, "config/config.toml"))
+	require.NoError(t, err)
+	require.Equal(t, "id0@validator0:26656", config.Get("p2p.persistent_peers"))
+	require.Equal(t, "tcp://0.0.0.0:26657", config.Get("rpc.laddr"))
+	require.Equal(t, "tcp://0.0.0.0:26656", config.Get("p2p.laddr"))
+
+	app, err := toml.LoadFile(filepath.Join(nodes[1].home, "config/app.toml"))
+	require.NoError(t, err)
+	require.Equal(t, "tcp://0.0.0.0:1317", app.Get("api.address"))
+	require.Equal(t, "0.0.0.0:9090", app.Get("grpc.address"))
+}
@@ -0,0 +1,292 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/faultproxy"
+)
+
+// FaultAction is an action of a fault scenario.
+type FaultAction string
+
+const (
+	// FaultPartition disconnects the nodes from all the other nodes.
+	FaultPartition FaultAction = "partition"
+
+	// FaultLatency adds latency and jitter to the connections of the nodes.
+	FaultLatency FaultAction = "latency"
+
+	// FaultDrop drops the data sent to and from the nodes at a rate.
+	FaultDrop FaultAction = "drop"
+
+	// FaultPause suspends the processes of the nodes.
+	FaultPause FaultAction = "pause"
+
+	// FaultResume resumes the suspended processes of the nodes.
+	FaultResume FaultAction = "resume"
+
+	// FaultHeal removes the network faults of the nodes.
+	FaultHeal FaultAction = "heal"
+)
+
+// FaultScenario is a script of faults injected in a multi-node testnet.
+type FaultScenario struct {
+	Faults []FaultStep `yaml:"faults"`
+}
+
+// FaultStep is a fault injected once the testnet reaches a block height.
+// The nodes are numbered from 1, in the same order as the config validators.
+type FaultStep struct {
+	Height  int64         `yaml:"height"`
+	Action  FaultAction   `yaml:"action"`
+	Nodes   []int         `yaml:"nodes,omitempty"`
+	Latency time.Duration `yaml:"latency,omitempty"`
+	Jitter  time.Duration `yaml:"jitter,omitempty"`
+	Rate    float64       `yaml:"rate,omitempty"`
+}
+
+// String returns a description of the fault step.
+func (s FaultStep) String() string {
+	nodes := "all nodes"
+	if len(s.Nodes) > 0 {
+		numbers := make([]string, len(s.Nodes))
+		for i, n := range s.Nodes {
+			numbers[i] = fmt.Sprint(n)
+		}
+		nodes = "node " + strings.Join(numbers, ", ")
+	}
+
+	switch s.Action {
+	case FaultLatency:
+		return fmt.Sprintf("%s %s (%s ± %s)", s.Action, nodes, s.Latency, s.Jitter)
+	case FaultDrop:
+		return fmt.Sprintf("%s %s (%.0f%%)", s.Action, nodes, s.Rate*100)
+	default:
+		return fmt.Sprintf("%s %s", s.Action, nodes)
+	}
+}
+
+// ParseFaultScenario reads a fault scenario file for a testnet with a number of nodes.
+// The steps of the scenario are sorted by block height.
+func ParseFaultScenario(path string, numNodes int) (FaultScenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return FaultScenario{}, err
+	}
+
+	var s FaultScenario
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return FaultScenario{}, errors.Errorf("invalid fault scenario %s: %w", path, err)
+	}
+
+	for i, step := range s.Faults {
+		if err := step.validate(numNodes); err != nil {
+			return FaultScenario{}, errors.Errorf("fault scenario %s: fault %d: %w", path, i+1, err)
+		}
+	}
+
+	sort.SliceStable(s.Faults, func(i, j int) bool {
+		return s.Faults[i].Height < s.Faults[j].Height
+	})
+	return s, nil
+}
+
+func (s FaultStep) validate(numNodes int) error {
+	if s.Height < 1 {
+		return errors.New("height must be greater than zero")
+	}
+
+	for _, n := range s.Nodes {
+		if n < 1 || n > numNodes {
+			return errors.Errorf("invalid node %d, the testnet has %d nodes", n, numNodes)
+		}
+	}
+
+	switch s.Action {
+	case FaultHeal:
+		return nil
+	case FaultPartition, FaultPause, FaultResume:
+	case FaultLatency:
+		if s.Latency <= 0 {
+			return errors.New("latency must be greater than zero")
+		}
+		if s.Jitter < 0 {
+			return errors.New("jitter can't be negative")
+		}
+	case FaultDrop:
+		if s.Rate <= 0 || s.Rate > 1 {
+			return errors.New("rate must be greater than 0 and lower or equal to 1")
+		}
+	default:
+		return errors.Errorf("unknown action %q", s.Action)
+	}
+
+	if len(s.Nodes) == 0 {
+		return errors.Errorf("%s requires nodes", s.Action)
+	}
+	return nil
+}
+
+// faultLink is the connection from a node to another one.
+type faultLink struct {
+	from, to int
+}
+
+// FaultNetwork connects the nodes of a multi-node testnet through proxies injecting network faults.
+// Each node reaches each of the other nodes through its own proxy, so the faults of a node
+// are injected on all the connections it opens or accepts.
+type FaultNetwork struct {
+	peers []string
+	links map[faultLink]*faultproxy.Proxy
+
+	mu     sync.Mutex
+	faults []faultproxy.Faults
+}
+
+// NewFaultNetwork creates the proxies between the nodes of the multi-node testnet.
+// The persistent peers of the node configs are not changed, the nodes must be
+// started with the flags returned by StartFlags to connect through the proxies.
+func NewFaultNetwork(args MultiNodeArgs) (*FaultNetwork, error) {
+	n := len(args.ListPorts)
+
+	// Persistent peers are listed in the same order as the nodes
+	configPath := filepath.Join(args.OutputDir, args.NodeDirPrefix+"0", "config/config.toml")
+	config, err := toml.LoadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+	peers := strings.Split(fmt.Sprint(config.Get("p2p.persistent_peers")), ",")
+	if len(peers) != n {
+		return nil, errors.Errorf("expected %d persistent peers, got %d", n, len(peers))
+	}
+
+	network := &FaultNetwork{
+		peers:  peers,
+		links:  make(map[faultLink]*faultproxy.Proxy),
+		faults: make([]faultproxy.Faults, n),
+	}
+	for from := 0; from < n; from++ {
+		for to := 0; to < n; to++ {
+			if from == to {
+				continue
+			}
+
+			_, target, _ := strings.Cut(peers[to], "@")
+			p, err := faultproxy.Listen("127.0.0.1:0", target)
+			if err != nil {
+				network.close()
+				return nil, err
+			}
+			network.links[faultLink{from, to}] = p
+		}
+	}
+	return network, nil
+}
+
+func (n *FaultNetwork) close() {
+	for _, p := range n.links {
+		p.Close()
+	}
+}
+
+// Serve forwards the connections between the nodes until the context is canceled.
+func (n *FaultNetwork) Serve(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, p := range n.links {
+		g.Go(func() error {
+			return p.Serve(ctx)
+		})
+	}
+	return g.Wait()
+}
+
+// StartFlags returns the flags of the start command connecting the node to
+// the other ones through the proxies. The peer exchange is disabled so the
+// nodes don't discover the direct addresses of each other.
+func (n *FaultNetwork) StartFlags(node int) []string {
+	var peers []string
+	for to, peer := range n.peers {
+		if to == node {
+			continue
+		}
+		id, _, _ := strings.Cut(peer, "@")
+		peers = append(peers, fmt.Sprintf("%s@%s", id, n.links[faultLink{node, to}].Addr()))
+	}
+	return []string{
+		"--p2p.persistent_peers", strings.Join(peers, ","),
+		"--p2p.pex=false",
+	}
+}
+
+// Faults returns the network faults of the node.
+func (n *FaultNetwork) Faults(node int) faultproxy.Faults {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.faults[node]
+}
+
+// SetFaults changes the network faults of the node.
+func (n *FaultNetwork) SetFaults(node int, f faultproxy.Faults) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.faults[node] = f
+
+	// The faults of a link combine the faults of both of its nodes
+	for l, p := range n.links {
+		if l.from != node && l.to != node {
+			continue
+		}
+		from, to := n.faults[l.from], n.faults[l.to]
+		p.SetFaults(faultproxy.Faults{
+			Partitioned: from.Partitioned || to.Partitioned,
+			Latency:     from.Latency + to.Latency,
+			Jitter:      from.Jitter + to.Jitter,
+			DropRate:    1 - (1-from.DropRate)*(1-to.DropRate),
+		})
+	}
+}
+
+// Apply applies the network fault of a scenario step.
+// Pausing and resuming the node processes is left to the caller.
+func (n *FaultNetwork) Apply(step FaultStep) error {
+	nodes := make([]int, len(step.Nodes))
+	for i, node := range step.Nodes {
+		nodes[i] = node - 1
+	}
+	if len(nodes) == 0 && step.Action == FaultHeal {
+		for node := range n.faults {
+			nodes = append(nodes, node)
+		}
+	}
+
+	for _, node := range nodes {
+		f := n.Faults(node)
+		switch step.Action {
+		case FaultPartition:
+			f.Partitioned = true
+		case FaultLatency:
+			f.Latency, f.Jitter = step.Latency, step.Jitter
+		case FaultDrop:
+			f.DropRate = step.Rate
+		case FaultHeal:
+			f = faultproxy.Faults{}
+		default:
+			return errors.Errorf("%s is not a network fault", step.Action)
+		}
+		n.SetFaults(node, f)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/faultproxy"
+)
+
+func TestParseFaultScenario(t *testing.T) {
+	tests := []struct {
+		name     string
+		scenario string
+		want     FaultScenario
+		err      string
+	}{
+		{
+			name: "valid scenario",
+			scenario: `
+faults:
+  - height: 20
+    action: heal
+  - height: 10
+    action: latency
+    nodes: [1, 2]
+    latency: 500ms
+    jitter: 100ms
+  - height: 10
+    action: pause
+    nodes: [3]
+  - height: 15
+    action: drop
+    nodes: [2]
+    rate: 0.1
+`,
+			want: FaultScenario{Faults: []FaultStep{
+				{Height: 10, Action: FaultLatency, Nodes: []int{1, 2}, Latency: 500 * time.Millisecond, Jitter: 100 * time.Millisecond},
+				{Height: 10, Action: FaultPause, Nodes: []int{3}},
+				{Height: 15, Action: FaultDrop, Nodes: []int{2}, Rate: 0.1},
+				{Height: 20, Action: FaultHeal},
+			}},
+		},
+		{
+			name: "unknown action",
+			scenario: `
+faults:
+  - height: 10
+    action: explode
+    nodes: [1]
+`,
+			err: `fault 1: unknown action "explode"`,
+		},
+		{
+			name: "invalid node",
+			scenario: `
+faults:
+  - height: 10
+    action: partition
+    nodes: [4]
+`,
+			err: "fault 1: invalid node 4, the testnet has 3 nodes",
+		},
+		{
+			name: "missing nodes",
+			scenario: `
+faults:
+  - height: 10
+    action: pause
+`,
+			err: "fault 1: pause requires nodes",
+		},
+		{
+			name: "invalid drop rate",
+			scenario: `
+faults:
+  - height: 10
+    action: drop
+    nodes: [1]
+    rate: 2
+`,
+			err: "fault 1: rate must be greater than 0 and lower or equal to 1",
+		},
+		{
+			name: "missing height",
+			scenario: `
+faults:
+  - action: heal
+`,
+			err: "fault 1: height must be greater than zero",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "faults.yml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.scenario), 0o644))
+
+			got, err := ParseFaultScenario(path, 3)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFaultNetwork(t *testing.T) {
+	args := MultiNodeArgs{
+		OutputDir:     t.TempDir(),
+		NodeDirPrefix: "validator",
+		ListPorts:     []uint{26657, 26654, 26651},
+	}
+	configDir := filepath.Join(args.OutputDir, "validator0", "config")
+	require.NoError(t, os.MkdirAll(configDir, 0o755))
+	config := `[p2p]
+persistent_peers = "id0@localhost:26656,id1@localhost:26653,id2@localhost:26650"
+`
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(config), 0o644))
+
+	network, err := NewFaultNetwork(args)
+	require.NoError(t, err)
+	defer network.close()
+
+	// The node reaches the other ones through the proxies
+	flags := network.StartFlags(1)
+	require.Len(t, flags, 3)
+	require.Equal(t, "--p2p.persistent_peers", flags[0])
+	require.Equal(t, "--p2p.pex=false", flags[2])
+	peers := strings.Split(flags[1], ",")
+	require.Len(t, peers, 2)
+	require.Equal(t, "id0@"+network.links[faultLink{1, 0}].Addr(), peers[0])
+	require.Equal(t, "id2@"+network.links[faultLink{1, 2}].Addr(), peers[1])
+	require.Equal(t, "localhost:26650", network.links[faultLink{1, 2}].Target())
+
+	// The faults of the nodes are combined on the links between them
+	require.NoError(t, network.Apply(FaultStep{Action: FaultLatency, Nodes: []int{1, 2}, Latency: time.Second}))
+	require.NoError(t, network.Apply(FaultStep{Action: FaultPartition, Nodes: []int{3}}))
+	require.Equal(t, faultproxy.Faults{Latency: 2 * time.Second}, network.links[faultLink{0, 1}].Faults())
+	require.Equal(t, faultproxy.Faults{Latency: time.Second, Partitioned: true}, network.links[faultLink{2, 0}].Faults())
+
+	require.NoError(t, network.Apply(FaultStep{Action: FaultHeal}))
+	for _, p := range network.links {
+		require.True(t, p.Faults().IsZero())
+	}
+
+	require.Error(t, network.Apply(FaultStep{Action: FaultPause, Nodes: []int{1}}))
+}
@@ -0,0 +1,325 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/otiai10/copy"
+	"github.com/pelletier/go-toml"
+	"golang.org/x/sync/errgroup"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	uilog "github.com/ignite/cli/v29/ignite/pkg/cliui/log"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+)
+
+// ErrNotEnoughValidators is returned when a local network is served with less than two validators.
+var ErrNotEnoughValidators = errors.New("at least two validators must be defined in the config to run a local network")
+
+// validatorNode is a node of the local network that runs one of the validators defined in the config.
+type validatorNode struct {
+	validator chainconfig.Validator
+	home      string
+	commands  chaincmdrunner.Runner
+}
+
+// moniker returns the moniker of the validator node.
+func (n validatorNode) moniker() string {
+	if n.validator.Gentx != nil && n.validator.Gentx.Moniker != "" {
+		return n.validator.Gentx.Moniker
+	}
+	return n.validator.Name
+}
+
+// ValidatorHomes returns the home directories of the nodes of the local network.
+// The first validator runs in the chain home and the other validators run in the home
+// defined in their config or, by default, in a directory next to the chain home
+// suffixed with the validator name.
+func (c *Chain) ValidatorHomes() ([]string, error) {
+	cfg, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	homes := make([]string, len(cfg.Validators))
+	for i, v := range cfg.Validators {
+		switch {
+		case i == 0:
+			homes[i] = home
+		case v.Home != "":
+			homes[i] = os.ExpandEnv(v.Home)
+		default:
+			homes[i] = fmt.Sprintf("%s-%s", home, v.Name)
+		}
+	}
+	return homes, nil
+}
+
+// validatorNodes returns the nodes of the local network, one for each validator of the config.
+func (c *Chain) validatorNodes(ctx context.Context, cfg *chainconfig.Config) ([]validatorNode, error) {
+	if len(cfg.Validators) < 2 {
+		return nil, ErrNotEnoughValidators
+	}
+
+	homes, err := c.ValidatorHomes()
+	if err != nil {
+		return nil, err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]validatorNode, len(cfg.Validators))
+	for i, v := range cfg.Validators {
+		servers, err := v.GetServers()
+		if err != nil {
+			return nil, err
+		}
+		nodeAddr, err := xurl.TCP(servers.RPC.Address)
+		if err != nil {
+			return nil, errors.Errorf("invalid rpc address format %s: %w", servers.RPC.Address, err)
+		}
+
+		cc := commands.Cmd().Copy(
+			chaincmd.WithHome(homes[i]),
+			chaincmd.WithNodeAddress(nodeAddr),
+		)
+
+		// Prefix the output of each node with the validator name, the output is
+		// only prefixed by the outputer in verbose mode so it is prefixed here
+		// in default mode to tell the nodes apart.
+		var options []chaincmdrunner.Option
+		if c.logOutputer != nil && c.logOutputer.Verbosity() != uilog.VerbositySilent {
+			out := c.logOutputer.NewOutput(v.Name, colors.Cyan)
+			if out.Verbosity() == uilog.VerbosityDefault {
+				out = uilog.NewOutput(
+					uilog.WithStdout(out.Stdout()),
+					uilog.WithStderr(out.Stderr()),
+					uilog.CustomVerbose(v.Name, colors.Cyan),
+				)
+			}
+			options = append(options,
+				chaincmdrunner.Stdout(out.Stdout()),
+				chaincmdrunner.Stderr(out.Stderr()),
+			)
+		}
+
+		runner, err := chaincmdrunner.New(ctx, cc, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes[i] = validatorNode{
+			validator: v,
+			home:      homes[i],
+			commands:  runner,
+		}
+	}
+	return nodes, nil
+}
+
+// InitValidators initializes a local network with a node for each validator of the config.
+// The chain is initialized in the home of the first validator, then the other nodes are
+// initialized and their gentxs are collected into the genesis shared by all the nodes.
+// The nodes are connected to each other with persistent peers.
+//
+// The validator accounts must be defined in the accounts of the config, they are all
+// kept in the keyring of the first validator node.
+func (c *Chain) InitValidators(ctx context.Context) error {
+	cfg, err := c.Config()
+	if err != nil {
+		return &CannotBuildAppError{err}
+	}
+
+	nodes, err := c.validatorNodes(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+
+	// init the chain and the first validator
+	if err := c.Init(ctx, InitArgsAll); err != nil {
+		return err
+	}
+
+	primary := nodes[0]
+	for _, node := range nodes[1:] {
+		c.ev.Send(fmt.Sprintf("Initializing validator %s...", node.validator.Name), events.ProgressUpdate())
+
+		if err := os.RemoveAll(node.home); err != nil {
+			return err
+		}
+		if err := node.commands.Init(ctx, node.moniker()); err != nil {
+			return err
+		}
+		if err := c.ConfigureValidator(node.home, chainID, node.validator); err != nil {
+			return err
+		}
+
+		nodeID, err := node.commands.ShowNodeID(ctx)
+		if err != nil {
+			return err
+		}
+		pubKey, err := node.commands.ShowValidator(ctx)
+		if err != nil {
+			return err
+		}
+
+		// the gentx is signed with the validator key of the first node keyring
+		// and uses the consensus key of the validator node.
+		v := createValidator(node.validator)
+		if _, err := primary.commands.Gentx(
+			ctx,
+			v.Name,
+			v.StakingAmount,
+			chaincmd.GentxWithPubKey(pubKey),
+			chaincmd.GentxWithNodeID(nodeID),
+			chaincmd.GentxWithMoniker(node.moniker()),
+			chaincmd.GentxWithCommissionRate(v.CommissionRate),
+			chaincmd.GentxWithCommissionMaxRate(v.CommissionMaxRate),
+			chaincmd.GentxWithCommissionMaxChangeRate(v.CommissionMaxChangeRate),
+			chaincmd.GentxWithMinSelfDelegation(v.MinSelfDelegation),
+			chaincmd.GentxWithGasPrices(v.GasPrices),
+			chaincmd.GentxWithDetails(v.Details),
+			chaincmd.GentxWithIdentity(v.Identity),
+			chaincmd.GentxWithWebsite(v.Website),
+			chaincmd.GentxWithSecurityContact(v.SecurityContact),
+		); err != nil {
+			return errors.Errorf("validator %s: %w", v.Name, err)
+		}
+	}
+
+	if err := primary.commands.CollectGentxs(ctx); err != nil {
+		return err
+	}
+
+	if err := c.shareGenesis(nodes); err != nil {
+		return err
+	}
+
+	return c.connectValidators(ctx, nodes)
+}
+
+// ResetValidators resets the database of the validator nodes and
+// restores the genesis of the first validator in all the nodes.
+func (c *Chain) ResetValidators(ctx context.Context) error {
+	cfg, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	nodes, err := c.validatorNodes(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes[1:] {
+		if err := node.commands.UnsafeReset(ctx); err != nil {
+			return err
+		}
+	}
+
+	return c.shareGenesis(nodes)
+}
+
+// shareGenesis copies the genesis of the first validator node to the other nodes.
+func (c *Chain) shareGenesis(nodes []validatorNode) error {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes[1:] {
+		if err := copy.Copy(genesisPath, filepath.Join(node.home, "config/genesis.json")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connectValidators sets the other validator nodes as persistent peers of each node.
+func (c *Chain) connectValidators(ctx context.Context, nodes []validatorNode) error {
+	peers := make([]string, len(nodes))
+	for i, node := range nodes {
+		nodeID, err := node.commands.ShowNodeID(ctx)
+		if err != nil {
+			return err
+		}
+
+		servers, err := node.validator.GetServers()
+		if err != nil {
+			return err
+		}
+		_, port, err := net.SplitHostPort(xurl.Address(servers.P2P.Address))
+		if err != nil {
+			return errors.Errorf("invalid p2p address format %s: %w", servers.P2P.Address, err)
+		}
+
+		peers[i] = fmt.Sprintf("%s@127.0.0.1:%s", nodeID, port)
+	}
+
+	for i, node := range nodes {
+		var nodePeers []string
+		for j, peer := range peers {
+			if i != j {
+				nodePeers = append(nodePeers, peer)
+			}
+		}
+
+		path := filepath.Join(node.home, "config/config.toml")
+		tmConfig, err := toml.LoadFile(path)
+		if err != nil {
+			return err
+		}
+
+		// All the nodes run on the same host
+		tmConfig.Set("p2p.persistent_peers", strings.Join(nodePeers, ","))
+		tmConfig.Set("p2p.allow_duplicate_ip", true)
+		tmConfig.Set("p2p.addr_book_strict", false)
+
+		if err := os.WriteFile(path, []byte(tmConfig.String()), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startValidators starts the nodes of the local network and stops all of them
+// when one of the nodes stops.
+func (c *Chain) startValidators(ctx context.Context, cfg *chainconfig.Config) error {
+	nodes, err := c.validatorNodes(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	for _, node := range nodes {
+		g.Go(func() error {
+			if err := c.StartValidator(ctx, node.commands, node.validator); err != nil {
+				return errors.Errorf("validator %s: %w", node.validator.Name, err)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
@@ -0,0 +1,45 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorHomes(t *testing.T) {
+	appPath := t.TempDir()
+	goMod := `module github.com/ignite/mars
+
+go 1.23
+
+require github.com/cosmos/cosmos-sdk v0.50.11
+`
+	config := `version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+  - name: bob
+    coins: ["100000000stake"]
+  - name: carol
+    coins: ["100000000stake"]
+validators:
+  - name: alice
+    bonded: 100000000stake
+  - name: bob
+    bonded: 100000000stake
+  - name: carol
+    bonded: 100000000stake
+    home: /tmp/carol
+`
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "go.mod"), []byte(goMod), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "config.yml"), []byte(config), 0o644))
+
+	c, err := New(appPath, HomePath("/tmp/mars"))
+	require.NoError(t, err)
+
+	homes, err := c.ValidatorHomes()
+	require.NoError(t, err)
+	require.Equal(t, []string{"/tmp/mars", "/tmp/mars-bob", "/tmp/carol"}, homes)
+}
@@ -0,0 +1,186 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+)
+
+const (
+	// wasmMsgStoreCode is the type URL of the message that uploads a contract.
+	wasmMsgStoreCode = "/cosmwasm.wasm.v1.MsgStoreCode"
+
+	// wasmMsgInstantiateContract is the type URL of the message that instantiates a contract.
+	wasmMsgInstantiateContract = "/cosmwasm.wasm.v1.MsgInstantiateContract"
+)
+
+// deployWasmContracts uploads the wasm contracts and instantiates them.
+// The addresses of the instantiated contracts are added to the addresses by contract name.
+func (c *Chain) deployWasmContracts(
+	ctx context.Context,
+	client cosmosclient.Client,
+	files *protoregistry.Files,
+	contracts []chainconfig.WasmContract,
+	addresses map[string]string,
+) error {
+	for _, contract := range contracts {
+		c.ev.Send(fmt.Sprintf("Uploading the wasm contract %s...", contract.Name), events.ProgressUpdate())
+
+		codeID, err := c.storeWasmCode(ctx, client, files, contract, addresses)
+		if err != nil {
+			return errors.Errorf("wasm contract %s: %w", contract.Name, err)
+		}
+
+		if contract.Instantiate == nil {
+			c.ev.Send(
+				fmt.Sprintf("Wasm contract %s uploaded with code ID %d", contract.Name, codeID),
+				events.ProgressFinish(),
+			)
+			continue
+		}
+
+		address, err := instantiateWasmContract(ctx, client, files, contract, codeID, addresses)
+		if err != nil {
+			return errors.Errorf("wasm contract %s: %w", contract.Name, err)
+		}
+		addresses[contract.Name] = address
+
+		c.ev.Send(
+			fmt.Sprintf("Wasm contract %s instantiated at %s", contract.Name, address),
+			events.ProgressFinish(),
+		)
+	}
+	return nil
+}
+
+// storeWasmCode uploads the contract code and returns its code ID.
+func (c *Chain) storeWasmCode(
+	ctx context.Context,
+	client cosmosclient.Client,
+	files *protoregistry.Files,
+	contract chainconfig.WasmContract,
+	addresses map[string]string,
+) (uint64, error) {
+	path := contract.Path
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.app.Path, path)
+	}
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"sender":         addresses[contract.Signer],
+		"wasm_byte_code": code,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := broadcastWasmMsg(ctx, client, files, contract.Signer, wasmMsgStoreCode, body)
+	if err != nil {
+		return 0, errors.Errorf("upload rejected: %w", err)
+	}
+
+	var stored struct {
+		CodeID string `json:"codeId"`
+	}
+	if err := json.Unmarshal(res, &stored); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(stored.CodeID, 10, 64)
+}
+
+// instantiateWasmContract instantiates the uploaded contract code and returns the address of the contract.
+func instantiateWasmContract(
+	ctx context.Context,
+	client cosmosclient.Client,
+	files *protoregistry.Files,
+	contract chainconfig.WasmContract,
+	codeID uint64,
+	addresses map[string]string,
+) (string, error) {
+	instantiate := contract.Instantiate
+
+	msg, err := json.Marshal(instantiate.Msg)
+	if err != nil {
+		return "", err
+	}
+	if msg, err = replaceSeedPlaceholders(msg, addresses); err != nil {
+		return "", err
+	}
+
+	funds, err := sdk.ParseCoinsNormalized(strings.Join(instantiate.Funds, ","))
+	if err != nil {
+		return "", errors.Errorf("invalid funds: %w", err)
+	}
+
+	label := instantiate.Label
+	if label == "" {
+		label = contract.Name
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"sender":  addresses[contract.Signer],
+		"admin":   addresses[instantiate.Admin],
+		"code_id": strconv.FormatUint(codeID, 10),
+		"label":   label,
+		"msg":     msg,
+		"funds":   funds,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	res, err := broadcastWasmMsg(ctx, client, files, contract.Signer, wasmMsgInstantiateContract, body)
+	if err != nil {
+		return "", errors.Errorf("instantiation rejected: %w", err)
+	}
+
+	var instantiated struct {
+		Address string `json:"address"`
+	}
+	if err := json.Unmarshal(res, &instantiated); err != nil {
+		return "", err
+	}
+	return instantiated.Address, nil
+}
+
+// broadcastWasmMsg broadcasts the wasm message and returns the JSON of its response.
+func broadcastWasmMsg(
+	ctx context.Context,
+	client cosmosclient.Client,
+	files *protoregistry.Files,
+	signer, typeURL string,
+	body []byte,
+) ([]byte, error) {
+	msg, err := cosmosclient.NewMsgFromJSON(files, typeURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Account(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.BroadcastTx(ctx, account, msg)
+	if err != nil {
+		return nil, err
+	}
+	return res.MsgResponseJSON(files, 0)
+}
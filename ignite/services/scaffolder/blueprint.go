@@ -0,0 +1,664 @@
+package scaffolder
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/placeholder"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/pkg/xos"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+	modulecreate "github.com/ignite/cli/v29/ignite/templates/module/create"
+)
+
+// Blueprint type kinds.
+const (
+	BlueprintKindList   = "list"
+	BlueprintKindMap    = "map"
+	BlueprintKindSingle = "single"
+	BlueprintKindType   = "type"
+)
+
+var (
+	isValidDependencyName = regexp.MustCompile(`^[a-zA-Z]+$`).MatchString
+
+	// stageSkipDirs are the directories not copied when staging a blueprint.
+	stageSkipDirs = map[string]struct{}{
+		".git":         {},
+		"node_modules": {},
+	}
+)
+
+// Blueprint describes a set of components to scaffold in a single run.
+type Blueprint struct {
+	Modules  []BlueprintModule  `yaml:"modules"`
+	Types    []BlueprintType    `yaml:"types"`
+	Messages []BlueprintMessage `yaml:"messages"`
+	Queries  []BlueprintQuery   `yaml:"queries"`
+	Packets  []BlueprintPacket  `yaml:"packets"`
+}
+
+// BlueprintModule describes a module to scaffold.
+type BlueprintModule struct {
+	Name     string   `yaml:"name"`
+	IBC      bool     `yaml:"ibc"`
+	Ordering string   `yaml:"ordering"`
+	Deps     []string `yaml:"deps"`
+	Params   []string `yaml:"params"`
+	Configs  []string `yaml:"configs"`
+}
+
+// BlueprintType describes a type to scaffold. Kind is one of list, map, single or type.
+type BlueprintType struct {
+	Name         string   `yaml:"name"`
+	Kind         string   `yaml:"kind"`
+	Module       string   `yaml:"module"`
+	Fields       []string `yaml:"fields"`
+	Index        string   `yaml:"index"`
+	Signer       string   `yaml:"signer"`
+	NoMessage    bool     `yaml:"no_message"`
+	NoSimulation bool     `yaml:"no_simulation"`
+}
+
+// BlueprintMessage describes a message to scaffold.
+type BlueprintMessage struct {
+	Name         string   `yaml:"name"`
+	Module       string   `yaml:"module"`
+	Fields       []string `yaml:"fields"`
+	Response     []string `yaml:"response"`
+	Description  string   `yaml:"desc"`
+	Signer       string   `yaml:"signer"`
+	NoSimulation bool     `yaml:"no_simulation"`
+}
+
+// BlueprintQuery describes a query to scaffold.
+type BlueprintQuery struct {
+	Name        string   `yaml:"name"`
+	Module      string   `yaml:"module"`
+	Fields      []string `yaml:"fields"`
+	Response    []string `yaml:"response"`
+	Description string   `yaml:"desc"`
+	Paginated   bool     `yaml:"paginated"`
+	HTTPPath    string   `yaml:"http_path"`
+	Store       string   `yaml:"store"`
+}
+
+// BlueprintPacket describes an IBC packet to scaffold.
+type BlueprintPacket struct {
+	Name      string   `yaml:"name"`
+	Module    string   `yaml:"module"`
+	Fields    []string `yaml:"fields"`
+	Ack       []string `yaml:"ack"`
+	Signer    string   `yaml:"signer"`
+	NoMessage bool     `yaml:"no_message"`
+}
+
+// ParseBlueprint decodes a YAML blueprint.
+func ParseBlueprint(r io.Reader) (Blueprint, error) {
+	var b Blueprint
+
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&b); err != nil && !errors.Is(err, io.EOF) {
+		return Blueprint{}, errors.Errorf("error parsing blueprint: %w", err)
+	}
+
+	return b, nil
+}
+
+// ParseBlueprintFile decodes a YAML blueprint from a file path.
+func ParseBlueprintFile(path string) (Blueprint, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Blueprint{}, err
+	}
+	defer f.Close()
+
+	return ParseBlueprint(f)
+}
+
+// ApplyBlueprint validates the blueprint and scaffolds all its components in order:
+// modules first, then types, messages, queries and packets.
+// The components are scaffolded in a temporary copy of the app and the app is only
+// modified once all of them succeeded, so a failing component leaves the app untouched.
+// Proto generation is left to PostScaffold.
+func (s Scaffolder) ApplyBlueprint(ctx context.Context, b Blueprint) (xgenny.SourceModification, error) {
+	tmpPath, tmpSm, _, err := s.stageBlueprint(ctx, b)
+	if tmpPath != "" {
+		defer os.RemoveAll(tmpPath)
+	}
+	if err != nil {
+		return xgenny.NewSourceModification(), err
+	}
+
+	sm, err := s.unstageModifications(tmpPath, tmpSm)
+	if err != nil {
+		return sm, err
+	}
+
+	// copy the scaffolded files from the staged copy to the app
+	for _, name := range append(tmpSm.CreatedFiles(), tmpSm.ModifiedFiles()...) {
+		relPath, err := filepath.Rel(tmpPath, name)
+		if err != nil {
+			return sm, err
+		}
+		dst := filepath.Join(s.appPath, relPath)
+		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			return sm, err
+		}
+		if err := xos.CopyFile(name, dst); err != nil {
+			return sm, err
+		}
+	}
+
+	return sm, nil
+}
+
+// stageBlueprint validates the blueprint and scaffolds it in a temporary copy of the app.
+// It returns the path of the copy, which must be removed by the caller, the modifications
+// made to the copy and the placeholder tracer of the run.
+func (s Scaffolder) stageBlueprint(
+	ctx context.Context,
+	b Blueprint,
+) (string, xgenny.SourceModification, *placeholder.Tracer, error) {
+	if err := validateBlueprint(s.appPath, s.modpath.Package, b); err != nil {
+		return "", xgenny.SourceModification{}, nil, err
+	}
+
+	tmpPath, err := os.MkdirTemp("", "ignite-blueprint")
+	if err != nil {
+		return "", xgenny.SourceModification{}, nil, err
+	}
+
+	if err := copyApp(s.appPath, tmpPath); err != nil {
+		return tmpPath, xgenny.SourceModification{}, nil, err
+	}
+
+	sc, err := New(ctx, tmpPath, s.protoDir)
+	if err != nil {
+		return tmpPath, xgenny.SourceModification{}, nil, err
+	}
+
+	sm, err := sc.scaffoldBlueprint(ctx, b)
+	return tmpPath, sm, sc.Tracer(), err
+}
+
+// unstageModifications points the modifications of the staged copy to the app.
+func (s Scaffolder) unstageModifications(tmpPath string, tmpSm xgenny.SourceModification) (xgenny.SourceModification, error) {
+	sm := xgenny.NewSourceModification()
+	for _, name := range tmpSm.ModifiedFiles() {
+		relPath, err := filepath.Rel(tmpPath, name)
+		if err != nil {
+			return sm, err
+		}
+		sm.AppendModifiedFiles(filepath.Join(s.appPath, relPath))
+	}
+	for _, name := range tmpSm.CreatedFiles() {
+		relPath, err := filepath.Rel(tmpPath, name)
+		if err != nil {
+			return sm, err
+		}
+		sm.AppendCreatedFiles(filepath.Join(s.appPath, relPath))
+	}
+	return sm, nil
+}
+
+// scaffoldBlueprint scaffolds all the blueprint components in the scaffolder app.
+// The modifications are written after each component so that later ones can rely on the previous ones.
+func (s Scaffolder) scaffoldBlueprint(ctx context.Context, b Blueprint) (xgenny.SourceModification, error) {
+	sm := xgenny.NewSourceModification()
+
+	apply := func(name string, run func() error) error {
+		if err := run(); err != nil {
+			return errors.Errorf("blueprint %s: %w", name, err)
+		}
+		smc, err := s.ApplyModifications()
+		if err != nil {
+			return err
+		}
+		sm.Merge(smc)
+		return nil
+	}
+
+	for _, m := range b.Modules {
+		options := []ModuleCreationOption{
+			WithParams(m.Params),
+			WithModuleConfigs(m.Configs),
+		}
+		if m.IBC {
+			options = append(options, WithIBCChannelOrdering(m.Ordering), WithIBC())
+		}
+		if len(m.Deps) > 0 {
+			deps := make([]modulecreate.Dependency, 0, len(m.Deps))
+			for _, dep := range m.Deps {
+				deps = append(deps, modulecreate.NewDependency(dep))
+			}
+			options = append(options, WithDependencies(deps))
+		}
+
+		if err := apply("module "+m.Name, func() error {
+			return s.CreateModule(m.Name, options...)
+		}); err != nil {
+			return sm, err
+		}
+	}
+
+	for _, t := range b.Types {
+		kind, err := blueprintTypeKind(t)
+		if err != nil {
+			return sm, err
+		}
+
+		options := []AddTypeOption{TypeWithFields(t.Fields...)}
+		if t.Module != "" {
+			options = append(options, TypeWithModule(t.Module))
+		}
+		if t.NoMessage {
+			options = append(options, TypeWithoutMessage())
+		} else {
+			if t.Signer != "" {
+				options = append(options, TypeWithSigner(t.Signer))
+			}
+			if t.NoSimulation {
+				options = append(options, TypeWithoutSimulation())
+			}
+		}
+
+		if err := apply(t.Kind+" "+t.Name, func() error {
+			return s.AddType(ctx, t.Name, kind, options...)
+		}); err != nil {
+			return sm, err
+		}
+	}
+
+	for _, m := range b.Messages {
+		var options []MessageOption
+		if m.Description != "" {
+			options = append(options, WithDescription(m.Description))
+		}
+		if m.Signer != "" {
+			options = append(options, WithSigner(m.Signer))
+		}
+		if m.NoSimulation {
+			options = append(options, WithoutSimulation())
+		}
+
+		if err := apply("message "+m.Name, func() error {
+			return s.AddMessage(ctx, m.Module, m.Name, m.Fields, m.Response, options...)
+		}); err != nil {
+			return sm, err
+		}
+	}
+
+	for _, q := range b.Queries {
+		desc := q.Description
+		if desc == "" {
+			desc = "Query " + q.Name
+		}
+
+		var options []QueryOption
+		if q.HTTPPath != "" {
+			options = append(options, QueryWithHTTPPath(q.HTTPPath))
+		}
+		if q.Store != "" {
+			options = append(options, QueryWithStore(q.Store))
+		}
+
+		if err := apply("query "+q.Name, func() error {
+			return s.AddQuery(ctx, q.Module, q.Name, desc, q.Fields, q.Response, q.Paginated, options...)
+		}); err != nil {
+			return sm, err
+		}
+	}
+
+	for _, p := range b.Packets {
+		var options []PacketOption
+		if p.NoMessage {
+			options = append(options, PacketWithoutMessage())
+		} else if p.Signer != "" {
+			options = append(options, PacketWithSigner(p.Signer))
+		}
+
+		if err := apply("packet "+p.Name, func() error {
+			return s.AddPacket(ctx, p.Module, p.Name, p.Fields, p.Ack, options...)
+		}); err != nil {
+			return sm, err
+		}
+	}
+
+	return sm, nil
+}
+
+// PreviewBlueprint scaffolds the blueprint in a temporary copy of the app and returns
+// the modifications, their unified diff against the app and the placeholder tracer of the run.
+// The app itself is left untouched.
+func (s Scaffolder) PreviewBlueprint(
+	ctx context.Context,
+	b Blueprint,
+) (xgenny.SourceModification, string, *placeholder.Tracer, error) {
+	tmpPath, tmpSm, tracer, err := s.stageBlueprint(ctx, b)
+	if tmpPath != "" {
+		defer os.RemoveAll(tmpPath)
+	}
+	if err != nil {
+		return xgenny.SourceModification{}, "", tracer, err
+	}
+
+	diff, err := xgenny.DiffFiles(s.appPath, tmpPath, tmpSm)
+	if err != nil {
+		return xgenny.SourceModification{}, "", tracer, err
+	}
+
+	sm, err := s.unstageModifications(tmpPath, tmpSm)
+	if err != nil {
+		return sm, "", tracer, err
+	}
+
+	return sm, diff, tracer, nil
+}
+
+// copyApp copies the app source to the destination path, skipping VCS and dependency folders.
+func copyApp(appPath, dstPath string) error {
+	return filepath.WalkDir(appPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(appPath, path)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(dstPath, relPath)
+
+		if d.IsDir() {
+			if _, ok := stageSkipDirs[d.Name()]; ok {
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(dst, 0o755)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		return xos.CopyFile(path, dst)
+	})
+}
+
+// blueprintTypeKind returns the type kind of the blueprint type.
+func blueprintTypeKind(t BlueprintType) (AddTypeKind, error) {
+	switch t.Kind {
+	case BlueprintKindList:
+		return ListType(), nil
+	case BlueprintKindMap:
+		index := t.Index
+		if index == "" {
+			index = "index"
+		}
+		return MapType(index), nil
+	case BlueprintKindSingle:
+		return SingletonType(), nil
+	case BlueprintKindType, "":
+		return DryType(), nil
+	default:
+		return nil, errors.Errorf(
+			"invalid kind %q for type %s, must be one of %s",
+			t.Kind,
+			t.Name,
+			strings.Join([]string{BlueprintKindList, BlueprintKindMap, BlueprintKindSingle, BlueprintKindType}, ", "),
+		)
+	}
+}
+
+// blueprintModules keeps track of the modules known while validating a blueprint.
+type blueprintModules struct {
+	appPath       string
+	defaultModule string
+
+	// declared are the modules declared in the blueprint, associated with their IBC flag.
+	declared map[string]bool
+
+	// components are the component names already declared per module.
+	components map[string]map[string]struct{}
+}
+
+// resolve returns the normalized module name and checks that it exists either in the app or in the blueprint.
+func (m blueprintModules) resolve(moduleName string) (string, error) {
+	if moduleName == "" {
+		moduleName = m.defaultModule
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return "", err
+	}
+	moduleName = mfName.LowerCase
+
+	if _, ok := m.declared[moduleName]; ok {
+		return moduleName, nil
+	}
+
+	ok, err := moduleExists(m.appPath, moduleName)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+	return moduleName, nil
+}
+
+// isIBC returns true if the module is or will be an IBC module.
+func (m blueprintModules) isIBC(moduleName string) (bool, error) {
+	if ibc, ok := m.declared[moduleName]; ok {
+		return ibc, nil
+	}
+	return isIBCModule(m.appPath, moduleName)
+}
+
+// checkComponent runs the component validity checks for a blueprint component.
+func (m blueprintModules) checkComponent(moduleName, componentName string, noMessage bool) error {
+	name, err := multiformatname.NewName(componentName)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := m.declared[moduleName]; ok {
+		// the module doesn't exist yet, only the name can be checked
+		if err := checkForbiddenComponentName(name); err != nil {
+			return errors.Errorf("%s can't be used as a component name: %w", name.LowerCamel, err)
+		}
+	} else if err := checkComponentValidity(m.appPath, moduleName, name, noMessage); err != nil {
+		return err
+	}
+
+	if _, ok := m.components[moduleName]; !ok {
+		m.components[moduleName] = make(map[string]struct{})
+	}
+	if _, ok := m.components[moduleName][name.LowerCase]; ok {
+		return errors.Errorf("component %s is declared more than once in module %s", name.Original, moduleName)
+	}
+	m.components[moduleName][name.LowerCase] = struct{}{}
+
+	return nil
+}
+
+// validateBlueprint checks all the components of the blueprint before anything is scaffolded.
+func validateBlueprint(appPath, defaultModule string, b Blueprint) error {
+	modules := blueprintModules{
+		appPath:       appPath,
+		defaultModule: defaultModule,
+		declared:      make(map[string]bool),
+		components:    make(map[string]map[string]struct{}),
+	}
+
+	for _, m := range b.Modules {
+		mfName, err := multiformatname.NewName(m.Name, multiformatname.NoNumber)
+		if err != nil {
+			return err
+		}
+		name := mfName.LowerCase
+
+		if _, ok := modules.declared[name]; ok {
+			return errors.Errorf("module %s is declared more than once", name)
+		}
+		if err := checkModuleName(appPath, name); err != nil {
+			return err
+		}
+		ok, err := moduleExists(appPath, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return errors.Errorf("the module %v already exists", name)
+		}
+		for declared := range modules.declared {
+			if strings.HasPrefix(name, declared) {
+				return errors.Errorf("the module name can't be prefixed with %s because of potential store key collision", declared)
+			}
+		}
+
+		switch m.Ordering {
+		case "", "none", "ordered", "unordered":
+		default:
+			return errors.Errorf("invalid channel ordering %q for module %s", m.Ordering, name)
+		}
+
+		for _, dep := range m.Deps {
+			if !isValidDependencyName(dep) {
+				return errors.Errorf("invalid module dependency name format '%s'", dep)
+			}
+		}
+		if _, err := field.ParseFields(m.Params, checkForbiddenTypeIndex); err != nil {
+			return errors.Errorf("module %s params: %w", name, err)
+		}
+		if _, err := field.ParseFields(m.Configs, checkForbiddenTypeIndex); err != nil {
+			return errors.Errorf("module %s configs: %w", name, err)
+		}
+
+		modules.declared[name] = m.IBC
+	}
+
+	for _, t := range b.Types {
+		moduleName, err := modules.resolve(t.Module)
+		if err != nil {
+			return errors.Errorf("type %s: %w", t.Name, err)
+		}
+		if _, err := blueprintTypeKind(t); err != nil {
+			return err
+		}
+		if err := modules.checkComponent(moduleName, t.Name, t.NoMessage); err != nil {
+			return err
+		}
+
+		o := newAddTypeOptions(moduleName)
+		o.fields = t.Fields
+		o.withoutMessage = t.NoMessage
+		if t.Signer != "" {
+			o.signer = t.Signer
+		}
+		switch t.Kind {
+		case BlueprintKindList:
+			o.isList = true
+		case BlueprintKindMap:
+			o.isMap = true
+		case BlueprintKindSingle:
+			o.isSingleton = true
+		}
+		if _, err := parseTypeFields(o); err != nil {
+			return errors.Errorf("type %s: %w", t.Name, err)
+		}
+		if o.isMap && t.Index != "" {
+			if err := checkForbiddenTypeIndex(t.Index); err != nil {
+				return errors.Errorf("type %s: %w", t.Name, err)
+			}
+		}
+	}
+
+	for _, msg := range b.Messages {
+		moduleName, err := modules.resolve(msg.Module)
+		if err != nil {
+			return errors.Errorf("message %s: %w", msg.Name, err)
+		}
+		if err := modules.checkComponent(moduleName, msg.Name, false); err != nil {
+			return err
+		}
+
+		signer := msg.Signer
+		if signer == "" {
+			signer = "creator"
+		}
+		if _, err := field.ParseFields(msg.Fields, checkForbiddenMessageField, signer); err != nil {
+			return errors.Errorf("message %s: %w", msg.Name, err)
+		}
+		if _, err := field.ParseFields(msg.Response, checkGoReservedWord, signer); err != nil {
+			return errors.Errorf("message %s response: %w", msg.Name, err)
+		}
+	}
+
+	for _, q := range b.Queries {
+		moduleName, err := modules.resolve(q.Module)
+		if err != nil {
+			return errors.Errorf("query %s: %w", q.Name, err)
+		}
+		if err := modules.checkComponent(moduleName, q.Name, true); err != nil {
+			return err
+		}
+
+		if containsCustomTypes(q.Fields) {
+			return errors.Errorf("query %s: request params can't contain custom type", q.Name)
+		}
+		reqFields, err := field.ParseFields(q.Fields, checkGoReservedWord)
+		if err != nil {
+			return errors.Errorf("query %s: %w", q.Name, err)
+		}
+		if err := checkHTTPPath(q.HTTPPath, reqFields); err != nil {
+			return errors.Errorf("query %s: %w", q.Name, err)
+		}
+		if _, err := field.ParseFields(q.Response, checkGoReservedWord); err != nil {
+			return errors.Errorf("query %s response: %w", q.Name, err)
+		}
+	}
+
+	for _, p := range b.Packets {
+		if p.Module == "" {
+			return errors.Errorf("packet %s: a module must be specified", p.Name)
+		}
+		moduleName, err := modules.resolve(p.Module)
+		if err != nil {
+			return errors.Errorf("packet %s: %w", p.Name, err)
+		}
+		ibc, err := modules.isIBC(moduleName)
+		if err != nil {
+			return err
+		}
+		if !ibc {
+			return errors.Errorf("packet %s: the module %s doesn't implement IBC module interface", p.Name, moduleName)
+		}
+		if err := modules.checkComponent(moduleName, p.Name, p.NoMessage); err != nil {
+			return err
+		}
+
+		signer := ""
+		if !p.NoMessage {
+			signer = p.Signer
+			if signer == "" {
+				signer = "creator"
+			}
+		}
+		if _, err := field.ParseFields(p.Fields, checkForbiddenPacketField, signer); err != nil {
+			return errors.Errorf("packet %s: %w", p.Name, err)
+		}
+		if _, err := field.ParseFields(p.Ack, checkGoReservedWord, signer); err != nil {
+			return errors.Errorf("packet %s ack: %w", p.Name, err)
+		}
+	}
+
+	return nil
+}
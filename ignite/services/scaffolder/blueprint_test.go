@@ -0,0 +1,155 @@
+package scaffolder
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlueprint(t *testing.T) {
+	b, err := ParseBlueprint(strings.NewReader(`
+modules:
+  - name: blog
+    deps: [bank]
+    params: [max:uint]
+types:
+  - name: post
+    kind: list
+    module: blog
+    fields: [title, body]
+messages:
+  - name: like-post
+    module: blog
+    fields: [id:uint]
+    response: [likes:uint]
+`))
+	require.NoError(t, err)
+	require.Equal(t, Blueprint{
+		Modules: []BlueprintModule{{Name: "blog", Deps: []string{"bank"}, Params: []string{"max:uint"}}},
+		Types:   []BlueprintType{{Name: "post", Kind: "list", Module: "blog", Fields: []string{"title", "body"}}},
+		Messages: []BlueprintMessage{
+			{Name: "like-post", Module: "blog", Fields: []string{"id:uint"}, Response: []string{"likes:uint"}},
+		},
+	}, b)
+
+	_, err = ParseBlueprint(strings.NewReader("types:\n  - name: post\n    unknown: true\n"))
+	require.Error(t, err)
+
+	b, err = ParseBlueprint(strings.NewReader(""))
+	require.NoError(t, err)
+	require.Equal(t, Blueprint{}, b)
+}
+
+func TestValidateBlueprint(t *testing.T) {
+	tests := []struct {
+		name      string
+		blueprint Blueprint
+		err       string
+	}{
+		{
+			name: "should validate a blueprint",
+			blueprint: Blueprint{
+				Modules: []BlueprintModule{
+					{Name: "blog", Params: []string{"max:uint"}},
+					{Name: "bridge", IBC: true, Ordering: "unordered"},
+				},
+				Types: []BlueprintType{
+					{Name: "post", Kind: BlueprintKindList, Module: "blog", Fields: []string{"title", "body"}},
+					{Name: "author", Kind: BlueprintKindMap, Module: "blog", Index: "address", Fields: []string{"name"}},
+				},
+				Messages: []BlueprintMessage{{Name: "like-post", Module: "blog", Fields: []string{"id:uint"}}},
+				Queries:  []BlueprintQuery{{Name: "count", Module: "blog", Response: []string{"count:uint"}}},
+				Packets:  []BlueprintPacket{{Name: "share", Module: "bridge", Fields: []string{"title"}}},
+			},
+		},
+		{
+			name: "should prevent duplicated module",
+			blueprint: Blueprint{
+				Modules: []BlueprintModule{{Name: "blog"}, {Name: "blog"}},
+			},
+			err: "module blog is declared more than once",
+		},
+		{
+			name: "should prevent reserved module name",
+			blueprint: Blueprint{
+				Modules: []BlueprintModule{{Name: "bank"}},
+			},
+			err: "bank is a reserved name and can't be used as a module name",
+		},
+		{
+			name: "should prevent invalid channel ordering",
+			blueprint: Blueprint{
+				Modules: []BlueprintModule{{Name: "bridge", IBC: true, Ordering: "random"}},
+			},
+			err: `invalid channel ordering "random" for module bridge`,
+		},
+		{
+			name: "should prevent unknown module",
+			blueprint: Blueprint{
+				Types: []BlueprintType{{Name: "post", Kind: BlueprintKindList, Module: "blog"}},
+			},
+			err: "type post: the module blog doesn't exist",
+		},
+		{
+			name: "should prevent invalid type kind",
+			blueprint: Blueprint{
+				Modules: []BlueprintModule{{Name: "blog"}},
+				Types:   []BlueprintType{{Name: "post", Kind: "tree", Module: "blog"}},
+			},
+			err: `invalid kind "tree" for type post`,
+		},
+		{
+			name: "should prevent forbidden component name",
+			blueprint: Blueprint{
+				Modules:  []BlueprintModule{{Name: "blog"}},
+				Messages: []BlueprintMessage{{Name: "genesis", Module: "blog"}},
+			},
+			err: "genesis can't be used as a component name",
+		},
+		{
+			name: "should prevent duplicated component",
+			blueprint: Blueprint{
+				Modules:  []BlueprintModule{{Name: "blog"}},
+				Types:    []BlueprintType{{Name: "post", Kind: BlueprintKindList, Module: "blog"}},
+				Messages: []BlueprintMessage{{Name: "post", Module: "blog"}},
+			},
+			err: "component post is declared more than once in module blog",
+		},
+		{
+			name: "should prevent forbidden type field",
+			blueprint: Blueprint{
+				Modules: []BlueprintModule{{Name: "blog"}},
+				Types:   []BlueprintType{{Name: "post", Kind: BlueprintKindList, Module: "blog", Fields: []string{"id"}}},
+			},
+			err: "id is used by type scaffolder",
+		},
+		{
+			name: "should prevent packet in a non IBC module",
+			blueprint: Blueprint{
+				Modules: []BlueprintModule{{Name: "blog"}},
+				Packets: []BlueprintPacket{{Name: "share", Module: "blog"}},
+			},
+			err: "packet share: the module blog doesn't implement IBC module interface",
+		},
+		{
+			name: "should prevent custom types in query request",
+			blueprint: Blueprint{
+				Modules: []BlueprintModule{{Name: "blog"}},
+				Queries: []BlueprintQuery{{Name: "find", Module: "blog", Fields: []string{"post:Post"}}},
+			},
+			err: "query find: request params can't contain custom type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlueprint(t.TempDir(), "app", tt.blueprint)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
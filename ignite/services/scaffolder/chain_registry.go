@@ -21,8 +21,8 @@ const (
 	assetListFilename = "assetlist.json"
 )
 
-// AddChainRegistryFiles generates the chain registry files in the scaffolded chains.
-func (s Scaffolder) AddChainRegistryFiles(chain *chain.Chain, cfg *chainconfig.Config) error {
+// AddChainRegistryFiles generates the chain registry files of the scaffolded chain in the output directory.
+func (s Scaffolder) AddChainRegistryFiles(chain *chain.Chain, cfg *chainconfig.Config, outDir string) error {
 	binaryName, err := chain.Binary()
 	if err != nil {
 		return errors.Wrap(err, "failed to get binary name")
@@ -172,11 +172,11 @@ func (s Scaffolder) AddChainRegistryFiles(chain *chain.Chain, cfg *chainconfig.C
 		},
 	}
 
-	if err := chainData.SaveJSON(chainFilename); err != nil {
+	if err := chainData.SaveJSON(filepath.Join(outDir, chainFilename)); err != nil {
 		return err
 	}
 
-	if err := assetListData.SaveJSON(assetListFilename); err != nil {
+	if err := assetListData.SaveJSON(filepath.Join(outDir, assetListFilename)); err != nil {
 		return err
 	}
 
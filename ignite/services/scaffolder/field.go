@@ -0,0 +1,95 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+	"github.com/ignite/cli/v29/ignite/templates/typed/addfield"
+)
+
+// AddField adds new fields to an existing type of the app. When the type was scaffolded
+// with the list, map or single commands, the CRUD messages, the autocli options and the
+// generated tests are updated too.
+func (s Scaffolder) AddField(
+	ctx context.Context,
+	moduleName,
+	typeName string,
+	fields []string,
+) error {
+	// If no module is provided, we add the fields to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(typeName)
+	if err != nil {
+		return err
+	}
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	opts := &addfield.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.appPath,
+		ProtoDir:   s.protoDir,
+		ProtoVer:   "v1", // TODO(@julienrbrt): possibly in the future add flag to specify custom proto version.
+		ModuleName: moduleName,
+		TypeName:   name,
+	}
+
+	if _, err := os.Stat(opts.ProtoFile(name.Snake + ".proto")); os.IsNotExist(err) {
+		return errors.Errorf("the type %s doesn't exist in module %s", name.Original, moduleName)
+	} else if err != nil {
+		return err
+	}
+
+	// Check and parse provided fields
+	if err := checkCustomTypes(ctx, s.appPath, s.modpath.Package, s.protoDir, moduleName, fields); err != nil {
+		return err
+	}
+	opts.Fields, err = field.ParseFields(fields, checkForbiddenTypeField)
+	if err != nil {
+		return err
+	}
+
+	// Detect how the type was scaffolded, a type without query is a simple type
+	var (
+		queryFile = opts.ProtoFile("query.proto")
+		hasGet    = protoFileHasMessage(queryFile, fmt.Sprintf("QueryGet%sRequest", name.UpperCamel))
+		hasAll    = protoFileHasMessage(queryFile, fmt.Sprintf("QueryAll%sRequest", name.UpperCamel))
+		hasCreate = protoFileHasMessage(opts.ProtoFile("tx.proto"), fmt.Sprintf("MsgCreate%s", name.UpperCamel))
+	)
+	opts.Singleton = hasGet && !hasAll
+	opts.NoMessage = !hasGet || !hasCreate
+
+	g, err := addfield.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+	return s.Run(g)
+}
+
+// protoFileHasMessage returns true if the proto file exists and defines the message.
+func protoFileHasMessage(path, name string) bool {
+	f, err := protoutil.ParseProtoPath(path)
+	if err != nil {
+		return false
+	}
+	return protoutil.HasMessage(f, name)
+}
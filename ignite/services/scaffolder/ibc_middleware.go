@@ -0,0 +1,78 @@
+package scaffolder
+
+import (
+	"strings"
+
+	appanalysis "github.com/ignite/cli/v29/ignite/pkg/cosmosanalysis/app"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/ibc"
+)
+
+// AddIBCMiddleware adds an IBC middleware to the app that wraps the IBC stack of the port.
+// The middleware is inserted in the IBC router of the app file that wires the IBC modules.
+func (s Scaffolder) AddIBCMiddleware(middlewareName, port string) error {
+	mfName, err := multiformatname.NewName(middlewareName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	middlewareName = mfName.LowerCase
+
+	// The middleware package is created in the modules directory
+	if err := checkModuleName(s.appPath, middlewareName); err != nil {
+		return err
+	}
+	ok, err := moduleExists(s.appPath, middlewareName)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return errors.Errorf("the module %v already exists", middlewareName)
+	}
+
+	ibcPath, err := appanalysis.FindIBCFilePath(s.appPath)
+	if errors.Is(err, appanalysis.ErrNoIBC) {
+		return errors.New("IBC middlewares require IBC, which is not part of minimal chains")
+	}
+	if err != nil {
+		return err
+	}
+
+	route, err := findIBCRoute(ibcPath, port)
+	if err != nil {
+		return err
+	}
+
+	g, err := ibc.NewMiddleware(&ibc.MiddlewareOptions{
+		AppPath:        s.appPath,
+		ModulePath:     s.modpath.RawPath,
+		IBCPath:        ibcPath,
+		MiddlewareName: middlewareName,
+		Route:          route,
+	})
+	if err != nil {
+		return err
+	}
+	return s.Run(g)
+}
+
+// findIBCRoute returns the route of the port in the IBC router of the app.
+func findIBCRoute(ibcPath, port string) (appanalysis.IBCRoute, error) {
+	routes, err := appanalysis.DiscoverIBCRoutes(ibcPath)
+	if err != nil {
+		return appanalysis.IBCRoute{}, err
+	}
+
+	ports := make([]string, 0, len(routes))
+	for _, route := range routes {
+		if route.Port == port {
+			return route, nil
+		}
+		ports = append(ports, route.Port)
+	}
+	return appanalysis.IBCRoute{}, errors.Errorf(
+		"the IBC router has no route for the %s port, the ports are: %s",
+		port,
+		strings.Join(ports, ", "),
+	)
+}
@@ -6,7 +6,6 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/ignite/cli/v29/ignite/pkg/cosmosgen"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
@@ -21,7 +20,7 @@ func Init(
 	ctx context.Context,
 	runner *xgenny.Runner,
 	root, name, addressPrefix, protoDir string,
-	noDefaultModule, minimal bool,
+	noDefaultModule, minimal, icaHost bool,
 	params, moduleConfigs []string,
 ) (string, string, error) {
 	pathInfo, err := gomodulepath.Parse(name)
@@ -60,6 +59,7 @@ func Init(
 		path,
 		noDefaultModule,
 		minimal,
+		icaHost,
 		params,
 		moduleConfigs,
 	)
@@ -74,7 +74,7 @@ func generate(
 	addressPrefix,
 	protoDir,
 	absRoot string,
-	noDefaultModule, minimal bool,
+	noDefaultModule, minimal, icaHost bool,
 	params, moduleConfigs []string,
 ) (xgenny.SourceModification, error) {
 	// Parse params with the associated type
@@ -105,6 +105,7 @@ func generate(
 		BinaryNamePrefix: pathInfo.Root,
 		AddressPrefix:    addressPrefix,
 		IsChainMinimal:   minimal,
+		IsICAHost:        icaHost,
 	})
 	if err != nil {
 		return xgenny.SourceModification{}, err
@@ -121,10 +122,6 @@ func generate(
 		return smc, err
 	}
 
-	if err := cosmosgen.InstallDepTools(ctx, absRoot); err != nil {
-		return smc, err
-	}
-
 	if !noDefaultModule {
 		opts := &modulecreate.CreateOptions{
 			ModuleName: pathInfo.Package, // App name
@@ -1,6 +1,7 @@
 package scaffolder
 
 import (
+	"bytes"
 	"go/token"
 	"os"
 	"path/filepath"
@@ -106,6 +107,12 @@ type moduleCreationOptions struct {
 
 	// dependencies list of module dependencies.
 	dependencies []modulecreate.Dependency
+
+	// integrationTests true if the module has an integration test suite.
+	integrationTests bool
+
+	// icaController true if the module registers interchain accounts with the ICA controller.
+	icaController bool
 }
 
 // ModuleCreationOption configures Chain.
@@ -153,6 +160,20 @@ func WithDependencies(dependencies []modulecreate.Dependency) ModuleCreationOpti
 	}
 }
 
+// WithIntegrationTests scaffolds a module with an integration test suite.
+func WithIntegrationTests() ModuleCreationOption {
+	return func(m *moduleCreationOptions) {
+		m.integrationTests = true
+	}
+}
+
+// WithICAController scaffolds a module that registers interchain accounts and sends messages over ICA.
+func WithICAController() ModuleCreationOption {
+	return func(m *moduleCreationOptions) {
+		m.icaController = true
+	}
+}
+
 // CreateModule creates a new empty module in the scaffolded app.
 func (s Scaffolder) CreateModule(
 	moduleName string,
@@ -201,18 +222,27 @@ func (s Scaffolder) CreateModule(
 		return err
 	}
 
+	// Check the module can be the authentication module of the ICA controller
+	if creationOpts.icaController {
+		if err := checkICAController(s.appPath); err != nil {
+			return err
+		}
+	}
+
 	opts := &modulecreate.CreateOptions{
-		ModuleName:   moduleName,
-		ModulePath:   s.modpath.RawPath,
-		Params:       params,
-		Configs:      configs,
-		AppName:      s.modpath.Package,
-		AppPath:      s.appPath,
-		ProtoDir:     s.protoDir,
-		ProtoVer:     "v1", // TODO(@julienrbrt): possibly in the future add flag to specify custom proto version.
-		IsIBC:        creationOpts.ibc,
-		IBCOrdering:  creationOpts.ibcChannelOrdering,
-		Dependencies: creationOpts.dependencies,
+		ModuleName:       moduleName,
+		ModulePath:       s.modpath.RawPath,
+		Params:           params,
+		Configs:          configs,
+		AppName:          s.modpath.Package,
+		AppPath:          s.appPath,
+		ProtoDir:         s.protoDir,
+		ProtoVer:         "v1", // TODO(@julienrbrt): possibly in the future add flag to specify custom proto version.
+		IsIBC:            creationOpts.ibc,
+		IBCOrdering:      creationOpts.ibcChannelOrdering,
+		Dependencies:     creationOpts.dependencies,
+		IntegrationTests: creationOpts.integrationTests,
+		IsICAController:  creationOpts.icaController,
 	}
 
 	g, err := modulecreate.NewGenerator(opts)
@@ -229,6 +259,24 @@ func (s Scaffolder) CreateModule(
 		}
 		gens = append(gens, g)
 	}
+
+	// Scaffold the integration test suite
+	if opts.IntegrationTests {
+		g, err = modulecreate.NewIntegrationTests(opts)
+		if err != nil {
+			return err
+		}
+		gens = append(gens, g)
+	}
+
+	// Scaffold the interchain accounts of the module
+	if opts.IsICAController {
+		g, err = modulecreate.NewICAController(opts)
+		if err != nil {
+			return err
+		}
+		gens = append(gens, g)
+	}
 	gens = append(gens, modulecreate.NewAppModify(s.Tracer(), opts))
 
 	err = s.Run(gens...)
@@ -302,6 +350,28 @@ func checkModuleName(appPath, moduleName string) error {
 	return nil
 }
 
+// checkICAController checks that the app wires the ICA controller without an authentication module,
+// the ICA controller middleware can only route the callbacks of the interchain accounts to a single module.
+func checkICAController(appPath string) error {
+	ibcPath, err := appanalysis.FindIBCFilePath(appPath)
+	if errors.Is(err, appanalysis.ErrNoIBC) {
+		return errors.New("interchain accounts require IBC, which is not part of minimal chains")
+	}
+	if err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(ibcPath)
+	if err != nil {
+		return err
+	}
+	if bytes.Contains(content, []byte("NewIBCMiddlewareWithAuth")) {
+		return errors.New("the ICA controller already has an authentication module")
+	}
+
+	return nil
+}
+
 // checkDependencies perform checks on the dependencies.
 func checkDependencies(dependencies []modulecreate.Dependency, appPath string) error {
 	depMap := make(map[string]struct{})
@@ -2,15 +2,49 @@ package scaffolder
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/emicklei/proto"
 	"github.com/gobuffalo/genny/v2"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
 	"github.com/ignite/cli/v29/ignite/templates/field"
+	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
 	"github.com/ignite/cli/v29/ignite/templates/query"
 )
 
+// httpPathParam matches the params of a REST path template, i.e. "{id}".
+var httpPathParam = regexp.MustCompile(`{([^{}]*)}`)
+
+// queryOptions represents configuration for the query scaffolding.
+type queryOptions struct {
+	httpPath string
+	store    string
+}
+
+// QueryOption configures the query scaffolding.
+type QueryOption func(*queryOptions)
+
+// QueryWithHTTPPath provides a custom REST path template for the query.
+// The path params must be request fields of the query, i.e. "/blog/posts/{id}".
+func QueryWithHTTPPath(path string) QueryOption {
+	return func(o *queryOptions) {
+		o.httpPath = path
+	}
+}
+
+// QueryWithStore binds the query to a map or a list of the module. The query
+// returns the paginated items of the store, filtered by the request fields.
+func QueryWithStore(typeName string) QueryOption {
+	return func(o *queryOptions) {
+		o.store = typeName
+	}
+}
+
 // AddQuery adds a new query to scaffolded app.
 func (s Scaffolder) AddQuery(
 	ctx context.Context,
@@ -20,7 +54,14 @@ func (s Scaffolder) AddQuery(
 	reqFields,
 	resFields []string,
 	paginated bool,
+	options ...QueryOption,
 ) error {
+	// apply options.
+	var o queryOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
 	// If no module is provided, we add the type to the app's module
 	if moduleName == "" {
 		moduleName = s.modpath.Package
@@ -58,6 +99,10 @@ func (s Scaffolder) AddQuery(
 		return err
 	}
 
+	if err := checkHTTPPath(o.httpPath, parsedReqFields); err != nil {
+		return err
+	}
+
 	var (
 		g    *genny.Generator
 		opts = &query.Options{
@@ -72,9 +117,21 @@ func (s Scaffolder) AddQuery(
 			ResFields:   parsedResFields,
 			Description: description,
 			Paginated:   paginated,
+			HTTPPath:    o.httpPath,
 		}
 	)
 
+	if o.store != "" {
+		if len(parsedResFields) > 0 {
+			return errors.New("a query bound to a store can't have response fields")
+		}
+		if opts.Store, err = queryStore(opts, o.store); err != nil {
+			return err
+		}
+		// the items of the store are always paginated
+		opts.Paginated = true
+	}
+
 	// Scaffold
 	g, err = query.NewGenerator(s.Tracer(), opts)
 	if err != nil {
@@ -83,3 +140,128 @@ func (s Scaffolder) AddQuery(
 
 	return s.Run(g)
 }
+
+// checkHTTPPath checks that the params of the REST path template are request fields.
+func checkHTTPPath(path string, reqFields field.Fields) error {
+	if path == "" {
+		return nil
+	}
+	if !strings.HasPrefix(path, "/") {
+		return errors.Errorf("the http path %s must start with a slash", path)
+	}
+
+	for _, match := range httpPathParam.FindAllStringSubmatch(path, -1) {
+		param := match[1]
+		found := false
+		for _, f := range reqFields {
+			if f.ProtoFieldName() == param {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("the http path param {%s} is not a request field", param)
+		}
+	}
+	return nil
+}
+
+// queryStore returns the store, scaffolded with the map or list commands, a query
+// is bound to. The request fields of the query are the filters of the store items.
+func queryStore(opts *query.Options, typeName string) (*query.Store, error) {
+	name, err := multiformatname.NewName(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	queryFile, err := protoutil.ParseProtoPath(opts.ProtoFile("query.proto"))
+	if err != nil {
+		return nil, err
+	}
+	if !protoutil.HasMessage(queryFile, fmt.Sprintf("QueryAll%sRequest", name.UpperCamel)) {
+		return nil, errors.Errorf("%s is not a map or a list of module %s", name.Original, opts.ModuleName)
+	}
+
+	// The index of the store is the request field of the get query
+	getRequest, err := protoutil.GetMessageByName(queryFile, fmt.Sprintf("QueryGet%sRequest", name.UpperCamel))
+	if err != nil {
+		return nil, err
+	}
+	indexFields := messageFields(getRequest)
+	if len(indexFields) != 1 {
+		return nil, errors.Errorf("can't find the index of %s", name.Original)
+	}
+	index, err := protoScalarField(indexFields[0])
+	if err != nil {
+		return nil, errors.Errorf("index of %s: %w", name.Original, err)
+	}
+
+	typeFile, err := protoutil.ParseProtoPath(opts.ProtoFile(name.Snake + ".proto"))
+	if err != nil {
+		return nil, err
+	}
+	typeMessage, err := protoutil.GetMessageByName(typeFile, name.UpperCamel)
+	if err != nil {
+		return nil, err
+	}
+	typeFields := make(map[string]string)
+	for _, f := range messageFields(typeMessage) {
+		typeFields[f.Name] = f.Type
+	}
+
+	store := &query.Store{TypeName: name, Index: index}
+	for _, reqField := range opts.ReqFields {
+		switch reqField.DatatypeName {
+		case datatype.String, datatype.Int, datatype.Uint:
+		default:
+			return nil, errors.Errorf("can't filter %s by %s, only string, int and uint fields are supported", name.Original, reqField.Name.Original)
+		}
+
+		protoType := reqField.ToProtoField(1).Type
+		switch {
+		case reqField.ProtoFieldName() == index.ProtoFieldName():
+			if protoType != indexFields[0].Type {
+				return nil, errors.Errorf("the field %s must have the type of the %s index", reqField.Name.Original, name.Original)
+			}
+			store.Filters = append(store.Filters, query.Filter{Field: reqField, OnIndex: true})
+		case typeFields[reqField.ProtoFieldName()] == "":
+			return nil, errors.Errorf("the field %s doesn't exist in %s", reqField.Name.Original, name.Original)
+		case typeFields[reqField.ProtoFieldName()] != protoType:
+			return nil, errors.Errorf("the field %s must have the type of the %s field", reqField.Name.Original, name.Original)
+		default:
+			store.Filters = append(store.Filters, query.Filter{Field: reqField})
+		}
+	}
+	return store, nil
+}
+
+// messageFields returns the fields of a proto message.
+func messageFields(msg *proto.Message) []*proto.NormalField {
+	var fields []*proto.NormalField
+	for _, el := range msg.Elements {
+		if f, ok := el.(*proto.NormalField); ok {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// protoScalarField returns the field for a proto field with a scalar type.
+func protoScalarField(f *proto.NormalField) (field.Field, error) {
+	datatypes := map[string]datatype.Name{
+		"string": datatype.String,
+		"uint64": datatype.Uint,
+		"int64":  datatype.Int,
+		"bool":   datatype.Bool,
+	}
+	dt, ok := datatypes[f.Type]
+	if !ok || f.Repeated {
+		return field.Field{}, errors.Errorf("unsupported type %s", f.Type)
+	}
+
+	fields, err := field.ParseFields([]string{f.Name + datatype.Separator + string(dt)}, checkGoReservedWord)
+	if err != nil {
+		return field.Field{}, err
+	}
+	return fields[0], nil
+}
@@ -0,0 +1,54 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+func TestCheckHTTPPath(t *testing.T) {
+	reqFields, err := field.ParseFields([]string{"id:uint", "owner"}, checkGoReservedWord)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		path        string
+		shouldError bool
+	}{
+		{
+			name: "should allow default path",
+			path: "",
+		},
+		{
+			name: "should allow path without params",
+			path: "/blog/posts",
+		},
+		{
+			name: "should allow request fields as params",
+			path: "/blog/owners/{owner}/posts/{id}",
+		},
+		{
+			name:        "should prevent relative path",
+			path:        "blog/posts/{id}",
+			shouldError: true,
+		},
+		{
+			name:        "should prevent unknown params",
+			path:        "/blog/posts/{title}",
+			shouldError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkHTTPPath(tc.path, reqFields)
+			if tc.shouldError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package scaffolder
+
+import (
+	"context"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/remove"
+)
+
+// RemoveComponent removes a scaffolded component from a module of the app.
+// The files created for the component are deleted and the code inserted
+// into the existing files of the module is removed.
+func (s Scaffolder) RemoveComponent(
+	_ context.Context,
+	kind remove.Kind,
+	moduleName,
+	componentName string,
+) error {
+	// If no module is provided, we remove the component from the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(componentName)
+	if err != nil {
+		return err
+	}
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	if kind == remove.KindPacket {
+		ok, err := isIBCModule(s.appPath, moduleName)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.Errorf("the module %s doesn't implement IBC module interface", moduleName)
+		}
+	}
+
+	opts := &remove.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.appPath,
+		ProtoDir:   s.protoDir,
+		ProtoVer:   "v1", // TODO(@julienrbrt): possibly in the future add flag to specify custom proto version.
+		ModuleName: moduleName,
+		Kind:       kind,
+		Name:       name,
+	}
+
+	exists, err := remove.Exists(opts)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf("%s %s not found in module %s", kind, name.Original, moduleName)
+	}
+
+	g, err := remove.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+	if err := s.Run(g); err != nil {
+		return err
+	}
+
+	files, err := remove.Files(opts)
+	if err != nil {
+		return err
+	}
+	s.runner.Delete(files...)
+
+	return nil
+}
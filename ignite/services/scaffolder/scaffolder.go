@@ -79,6 +79,12 @@ func (s Scaffolder) ApplyModifications() (xgenny.SourceModification, error) {
 	return s.runner.ApplyModifications()
 }
 
+// PreviewModifications returns the staged modifications and their unified diff
+// without applying them to the app.
+func (s Scaffolder) PreviewModifications() (xgenny.SourceModification, string, error) {
+	return s.runner.PreviewModifications()
+}
+
 func (s Scaffolder) Tracer() *placeholder.Tracer {
 	return s.runner.Tracer()
 }
@@ -149,6 +155,14 @@ func protoc(ctx context.Context, cacheStorage cache.Storage, projectPath, protoD
 		}
 
 		options = append(options, cosmosgen.WithOpenAPIGeneration(openAPIPath))
+
+		if openAPIV3Path := conf.Client.OpenAPI.V3Path; openAPIV3Path != "" {
+			if !filepath.IsAbs(openAPIV3Path) {
+				openAPIV3Path = filepath.Join(projectPath, openAPIV3Path)
+			}
+
+			options = append(options, cosmosgen.WithOpenAPIV3Generation(openAPIV3Path))
+		}
 	}
 
 	return cosmosgen.Generate(ctx, cacheStorage, projectPath, protoDir, gomodPath, options...)
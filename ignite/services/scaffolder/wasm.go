@@ -0,0 +1,108 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/goanalysis"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodule"
+	"github.com/ignite/cli/v29/ignite/templates/module"
+	"github.com/ignite/cli/v29/ignite/templates/wasm"
+)
+
+// wasmOptions represents configuration for the wasm module.
+type wasmOptions struct {
+	queryGasLimit      uint64
+	memoryCacheSize    uint32
+	simulationGasLimit uint64
+}
+
+// newWasmOptions returns a wasmOptions with default options.
+func newWasmOptions() wasmOptions {
+	return wasmOptions{
+		queryGasLimit:      wasm.DefaultQueryGasLimit,
+		memoryCacheSize:    wasm.DefaultMemoryCacheSize,
+		simulationGasLimit: wasm.DefaultSimulationGasLimit,
+	}
+}
+
+// WasmOption configures the wasm module added to the app.
+type WasmOption func(*wasmOptions)
+
+// WasmWithQueryGasLimit sets the maximum gas used by the smart queries of the contracts.
+func WasmWithQueryGasLimit(limit uint64) WasmOption {
+	return func(o *wasmOptions) {
+		o.queryGasLimit = limit
+	}
+}
+
+// WasmWithMemoryCacheSize sets the size of the in-memory cache of the contracts, in MiB.
+func WasmWithMemoryCacheSize(size uint32) WasmOption {
+	return func(o *wasmOptions) {
+		o.memoryCacheSize = size
+	}
+}
+
+// WasmWithSimulationGasLimit sets the maximum gas used by the transaction simulations.
+func WasmWithSimulationGasLimit(limit uint64) WasmOption {
+	return func(o *wasmOptions) {
+		o.simulationGasLimit = limit
+	}
+}
+
+// AddWasm adds the wasm module to the app to run CosmWasm contracts.
+func (s Scaffolder) AddWasm(options ...WasmOption) error {
+	o := newWasmOptions()
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if err := checkWasmAddable(s.appPath); err != nil {
+		return err
+	}
+
+	cmdPath, err := goanalysis.DiscoverOneMain(filepath.Join(s.appPath, "cmd"))
+	if err != nil {
+		return err
+	}
+
+	configPath, err := chainconfig.LocateDefault(s.appPath)
+	if err != nil {
+		return err
+	}
+
+	g, err := wasm.NewGenerator(s.Tracer(), &wasm.Options{
+		AppPath:            s.appPath,
+		CmdPath:            cmdPath,
+		ConfigPath:         configPath,
+		QueryGasLimit:      o.queryGasLimit,
+		MemoryCacheSize:    o.memoryCacheSize,
+		SimulationGasLimit: o.simulationGasLimit,
+	})
+	if err != nil {
+		return err
+	}
+	return s.Run(g)
+}
+
+// checkWasmAddable checks that the app supports IBC and doesn't already have the wasm module.
+func checkWasmAddable(appPath string) error {
+	gomod, err := gomodule.ParseAt(appPath)
+	if err != nil {
+		return err
+	}
+	for _, r := range gomod.Require {
+		if r.Mod.Path == wasm.WasmdModule {
+			return errors.New("the wasm module is already added to the app")
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(appPath, module.PathIBCConfigGo)); os.IsNotExist(err) {
+		return errors.New("the wasm module requires IBC, which is not part of minimal chains")
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/hermes"
+)
+
+// DefaultConfigFile is the default name of the workspace config file.
+const DefaultConfigFile = "ignite-workspace.yml"
+
+type (
+	// Config defines the chains of a workspace and the channels opened between them.
+	Config struct {
+		Chains  []Chain `yaml:"chains"`
+		Relayer Relayer `yaml:"relayer,omitempty"`
+	}
+
+	// Chain is a chain served by the workspace.
+	Chain struct {
+		// Name identifies the chain in the workspace.
+		Name string `yaml:"name"`
+
+		// Path is the path of the chain source code.
+		Path string `yaml:"path"`
+
+		// Config is the path of a custom chain config file.
+		Config string `yaml:"config,omitempty"`
+
+		// Home overwrites the home of the chain.
+		Home string `yaml:"home,omitempty"`
+	}
+
+	// Relayer configures the relayer of the channels.
+	Relayer struct {
+		// Binary is the path of the Hermes binary, "hermes" by default.
+		Binary string `yaml:"binary,omitempty"`
+
+		// Account is the name of the relayer account added to the chains, "relayer" by default.
+		Account string `yaml:"account,omitempty"`
+
+		// Channels lists the channels opened between the chains.
+		Channels []Channel `yaml:"channels,omitempty"`
+	}
+
+	// Channel is a channel opened between the modules of two chains.
+	Channel struct {
+		A       ChannelEnd `yaml:"a"`
+		B       ChannelEnd `yaml:"b"`
+		Version string     `yaml:"version,omitempty"`
+		Order   string     `yaml:"order,omitempty"`
+	}
+
+	// ChannelEnd is the port of a chain module.
+	ChannelEnd struct {
+		Chain string `yaml:"chain"`
+		Port  string `yaml:"port"`
+	}
+)
+
+// String returns the chain and the port of the channel end.
+func (e ChannelEnd) String() string {
+	return fmt.Sprintf("%s:%s", e.Chain, e.Port)
+}
+
+// ParseConfig parses a workspace config file.
+// The relative paths of the chains are resolved from the directory of the config file.
+func ParseConfig(path string) (Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Config{}, err
+	}
+	defer f.Close()
+
+	var cfg Config
+	if err := yaml.NewDecoder(f).Decode(&cfg); err != nil {
+		return Config{}, errors.Errorf("error parsing workspace file: %w", err)
+	}
+
+	if cfg.Relayer.Binary == "" {
+		cfg.Relayer.Binary = hermes.DefaultBinary
+	}
+	if cfg.Relayer.Account == "" {
+		cfg.Relayer.Account = defaultRelayerAccount
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return Config{}, err
+	}
+	for i := range cfg.Chains {
+		c := &cfg.Chains[i]
+		c.Path = resolvePath(dir, c.Path)
+		if c.Config != "" {
+			c.Config = resolvePath(dir, c.Config)
+		}
+		if c.Home != "" {
+			c.Home = resolvePath(dir, c.Home)
+		}
+	}
+
+	if err := cfg.validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+func (c Config) validate() error {
+	if len(c.Chains) == 0 {
+		return errors.New("the workspace must define at least one chain")
+	}
+
+	names := make(map[string]bool)
+	for i, chain := range c.Chains {
+		switch {
+		case chain.Name == "":
+			return errors.Errorf("chain %d: name is required", i+1)
+		case names[chain.Name]:
+			return errors.Errorf("chain %s is defined more than once", chain.Name)
+		case chain.Path == "":
+			return errors.Errorf("chain %s: path is required", chain.Name)
+		}
+		names[chain.Name] = true
+	}
+
+	for i, channel := range c.Relayer.Channels {
+		for _, end := range []ChannelEnd{channel.A, channel.B} {
+			if !names[end.Chain] {
+				return errors.Errorf("channel %d: unknown chain %q", i+1, end.Chain)
+			}
+			if end.Port == "" {
+				return errors.Errorf("channel %d: port is required", i+1)
+			}
+		}
+
+		if channel.A.Chain == channel.B.Chain {
+			return errors.Errorf("channel %d: the channel must connect two different chains", i+1)
+		}
+
+		switch channel.Order {
+		case "", hermes.OrderOrdered, hermes.OrderUnordered:
+		default:
+			return errors.Errorf(
+				"channel %d: order must be %s or %s",
+				i+1,
+				hermes.OrderOrdered,
+				hermes.OrderUnordered,
+			)
+		}
+	}
+	return nil
+}
+
+func resolvePath(dir, path string) string {
+	if filepath.IsAbs(path) || path == "" {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
@@ -0,0 +1,141 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		want   Config
+		err    string
+	}{
+		{
+			name: "valid workspace",
+			config: `
+chains:
+  - name: mars
+    path: ./mars
+    config: mars/mars.yml
+  - name: venus
+    path: /src/venus
+    home: homes/venus
+relayer:
+  channels:
+    - a: { chain: mars, port: blog }
+      b: { chain: venus, port: blog }
+      version: blog-1
+      order: ordered
+`,
+			want: Config{
+				Chains: []Chain{
+					{Name: "mars", Path: "mars", Config: "mars/mars.yml"},
+					{Name: "venus", Path: "/src/venus", Home: "homes/venus"},
+				},
+				Relayer: Relayer{
+					Binary:  "hermes",
+					Account: "relayer",
+					Channels: []Channel{{
+						A:       ChannelEnd{Chain: "mars", Port: "blog"},
+						B:       ChannelEnd{Chain: "venus", Port: "blog"},
+						Version: "blog-1",
+						Order:   "ordered",
+					}},
+				},
+			},
+		},
+		{
+			name:   "no chains",
+			config: `chains: []`,
+			err:    "the workspace must define at least one chain",
+		},
+		{
+			name: "duplicated chain",
+			config: `
+chains:
+  - name: mars
+    path: ./mars
+  - name: mars
+    path: ./venus
+`,
+			err: "chain mars is defined more than once",
+		},
+		{
+			name: "missing path",
+			config: `
+chains:
+  - name: mars
+`,
+			err: "chain mars: path is required",
+		},
+		{
+			name: "unknown channel chain",
+			config: `
+chains:
+  - name: mars
+    path: ./mars
+relayer:
+  channels:
+    - a: { chain: mars, port: blog }
+      b: { chain: venus, port: blog }
+`,
+			err: `channel 1: unknown chain "venus"`,
+		},
+		{
+			name: "same channel chain",
+			config: `
+chains:
+  - name: mars
+    path: ./mars
+relayer:
+  channels:
+    - a: { chain: mars, port: blog }
+      b: { chain: mars, port: blog }
+`,
+			err: "channel 1: the channel must connect two different chains",
+		},
+		{
+			name: "invalid order",
+			config: `
+chains:
+  - name: mars
+    path: ./mars
+  - name: venus
+    path: ./venus
+relayer:
+  channels:
+    - a: { chain: mars, port: blog }
+      b: { chain: venus, port: blog }
+      order: random
+`,
+			err: "channel 1: order must be ordered or unordered",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, DefaultConfigFile)
+			require.NoError(t, os.WriteFile(path, []byte(tt.config), 0o644))
+
+			got, err := ParseConfig(path)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// Relative paths are resolved from the workspace file directory
+			for i, c := range tt.want.Chains {
+				tt.want.Chains[i].Path = resolvePath(dir, c.Path)
+				tt.want.Chains[i].Config = resolvePath(dir, c.Config)
+				tt.want.Chains[i].Home = resolvePath(dir, c.Home)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
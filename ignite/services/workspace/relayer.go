@@ -0,0 +1,338 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/cosmos/go-bip39"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/config"
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	uilog "github.com/ignite/cli/v29/ignite/pkg/cliui/log"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/hermes"
+	"github.com/ignite/cli/v29/ignite/pkg/tendermintrpc"
+	"github.com/ignite/cli/v29/ignite/pkg/xexec"
+	"github.com/ignite/cli/v29/ignite/pkg/xfilepath"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+)
+
+const (
+	// defaultRelayerAccount is the default name of the relayer account added to the chains.
+	defaultRelayerAccount = "relayer"
+
+	// relayerCoinsAmount is the amount of the fee denom of the chains given to the relayer account.
+	relayerCoinsAmount = 100000000000
+
+	// relayerRetryDelay is the delay between two attempts to reach a chain.
+	relayerRetryDelay = time.Second
+
+	// relayerMnemonicFile is the file of the relayer account mnemonic.
+	relayerMnemonicFile = "relayer_mnemonic"
+
+	// hermesConfigFile is the file of the generated Hermes config.
+	hermesConfigFile = "hermes.toml"
+)
+
+// workspacePath is the place where the relayer account and config are saved.
+var workspacePath = xfilepath.Join(config.DirPath, xfilepath.Path("workspace"))
+
+// relayerMnemonic returns the mnemonic of the relayer account.
+// The mnemonic is created once and saved to keep the account
+// funded when the state of the chains is restored.
+func relayerMnemonic() (string, error) {
+	dir, err := workspacePath()
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, relayerMnemonicFile)
+	if b, err := os.ReadFile(path); err == nil {
+		return strings.TrimSpace(string(b)), nil
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	entropy, err := bip39.NewEntropy(256)
+	if err != nil {
+		return "", err
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, []byte(mnemonic), 0o600); err != nil {
+		return "", err
+	}
+	return mnemonic, nil
+}
+
+// addRelayerAccount adds the relayer account funded with the fee denom to the chain config.
+func addRelayerAccount(cfg *chainconfig.Config, name, mnemonic string) error {
+	for _, a := range cfg.Accounts {
+		if a.Name == name {
+			return errors.Errorf("account %s is reserved for the relayer, change the relayer account of the workspace", name)
+		}
+	}
+
+	price, err := relayerGasPrice(cfg)
+	if err != nil {
+		return err
+	}
+
+	cfg.Accounts = append(cfg.Accounts, base.Account{
+		Name:     name,
+		Mnemonic: mnemonic,
+		Coins:    []string{fmt.Sprintf("%d%s", relayerCoinsAmount, price.Denom)},
+	})
+	return nil
+}
+
+// relayerGasPrice returns the gas price paid by the relayer, which is the minimum
+// gas price of the first validator or free gas with the bonded denom by default.
+func relayerGasPrice(cfg *chainconfig.Config) (sdk.DecCoin, error) {
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if prices, ok := validator.App["minimum-gas-prices"].(string); ok && prices != "" {
+		coins, err := sdk.ParseDecCoins(prices)
+		if err != nil {
+			return sdk.DecCoin{}, errors.Errorf("invalid minimum gas prices %s: %w", prices, err)
+		}
+		if len(coins) > 0 {
+			return coins[0], nil
+		}
+	}
+
+	bonded, err := sdk.ParseCoinNormalized(validator.Bonded)
+	if err != nil {
+		return sdk.DecCoin{}, errors.Errorf("invalid bonded amount %s: %w", validator.Bonded, err)
+	}
+	return sdk.NewDecCoinFromDec(bonded.Denom, math.LegacyZeroDec()), nil
+}
+
+// relay waits for the chains to be started, opens the channels and relays their packets.
+func (w *Workspace) relay(ctx context.Context) error {
+	binary := w.config.Relayer.Binary
+	if !xexec.IsCommandAvailable(binary) {
+		return errors.Errorf(
+			"relayer binary %s not found, install Hermes (https://hermes.informal.systems) or set the relayer binary of the workspace",
+			binary,
+		)
+	}
+
+	dir, err := workspacePath()
+	if err != nil {
+		return err
+	}
+
+	options := []hermes.Option{hermes.WithBinary(binary)}
+	if w.logOutputer != nil && w.logOutputer.Verbosity() == uilog.VerbosityVerbose {
+		out := w.logOutputer.NewOutput("hermes", colors.Magenta)
+		options = append(options, hermes.WithStdout(out.Stdout()), hermes.WithStderr(out.Stderr()))
+	}
+	h := hermes.New(filepath.Join(dir, hermesConfigFile), options...)
+
+	// Only the chains connected by channels are relayed
+	var (
+		cfg = hermes.DefaultConfig()
+		ids = make(map[string]string)
+	)
+	for _, c := range w.chains {
+		if !w.isRelayed(c.Name) {
+			continue
+		}
+
+		chainConfig, err := w.relayerChainConfig(ctx, c)
+		if err != nil {
+			return errors.Errorf("chain %s: %w", c.Name, err)
+		}
+		cfg.Chains = append(cfg.Chains, chainConfig)
+		ids[c.Name] = chainConfig.ID
+	}
+
+	if err := cfg.Save(h.ConfigPath()); err != nil {
+		return err
+	}
+
+	for _, c := range cfg.Chains {
+		if err := h.AddKey(ctx, c.ID, c.KeyName, w.mnemonic); err != nil {
+			return errors.Errorf("adding relayer key for chain %s: %w", c.ID, err)
+		}
+	}
+
+	for _, channel := range w.config.Relayer.Channels {
+		if err := w.openChannel(ctx, h, channel, ids); err != nil {
+			return err
+		}
+	}
+
+	w.ev.Send(
+		fmt.Sprintf("Relaying packets with %s", colors.Faint(h.ConfigPath())),
+		events.Icon(icons.Earth),
+	)
+
+	if err := h.Start(ctx); err != nil && ctx.Err() == nil {
+		return errors.Errorf("relayer: %w", err)
+	}
+	return ctx.Err()
+}
+
+// openChannel opens a channel unless it is already opened on both chains.
+func (w *Workspace) openChannel(ctx context.Context, h hermes.Hermes, channel Channel, ids map[string]string) error {
+	name := fmt.Sprintf("%s <-> %s", channel.A, channel.B)
+
+	aOpened, err := hasChannel(ctx, h, ids[channel.A.Chain], ids[channel.B.Chain], channel.A.Port)
+	if err != nil {
+		return err
+	}
+	bOpened, err := hasChannel(ctx, h, ids[channel.B.Chain], ids[channel.A.Chain], channel.B.Port)
+	if err != nil {
+		return err
+	}
+	if aOpened && bOpened {
+		w.ev.Send(fmt.Sprintf("Channel %s already opened", name), events.Icon(icons.OK))
+		return nil
+	}
+
+	w.ev.Send(fmt.Sprintf("Opening channel %s...", name), events.ProgressStart())
+
+	err = h.CreateChannel(ctx, hermes.ChannelOptions{
+		AChain:  ids[channel.A.Chain],
+		APort:   channel.A.Port,
+		BChain:  ids[channel.B.Chain],
+		BPort:   channel.B.Port,
+		Version: channel.Version,
+		Order:   channel.Order,
+	})
+	if err != nil {
+		return errors.Errorf("opening channel %s: %w", name, err)
+	}
+
+	w.ev.Send(fmt.Sprintf("Channel %s opened", name), events.Icon(icons.OK), events.ProgressFinish())
+	return nil
+}
+
+// relayerChainConfig waits for a chain to be started and returns its relayer config.
+func (w *Workspace) relayerChainConfig(ctx context.Context, c workspaceChain) (hermes.ChainConfig, error) {
+	id, err := c.chain.ID()
+	if err != nil {
+		return hermes.ChainConfig{}, err
+	}
+
+	cfg, err := c.chain.Config()
+	if err != nil {
+		return hermes.ChainConfig{}, err
+	}
+
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return hermes.ChainConfig{}, err
+	}
+
+	servers, err := validator.GetServers()
+	if err != nil {
+		return hermes.ChainConfig{}, err
+	}
+
+	rpcAddr, err := xurl.HTTP(servers.RPC.Address)
+	if err != nil {
+		return hermes.ChainConfig{}, errors.Errorf("invalid rpc address format %s: %w", servers.RPC.Address, err)
+	}
+	grpcAddr, err := xurl.HTTP(servers.GRPC.Address)
+	if err != nil {
+		return hermes.ChainConfig{}, errors.Errorf("invalid grpc address format %s: %w", servers.GRPC.Address, err)
+	}
+	wsAddr, err := xurl.WS(servers.RPC.Address)
+	if err != nil {
+		return hermes.ChainConfig{}, errors.Errorf("invalid rpc address format %s: %w", servers.RPC.Address, err)
+	}
+
+	// The chain must produce blocks before its channels are opened
+	rpc := tendermintrpc.New(rpcAddr)
+	waitBlock := func() error {
+		height, err := rpc.LatestBlockHeight(ctx)
+		if err != nil {
+			return err
+		}
+		if height < 1 {
+			return errors.New("waiting for the first block")
+		}
+		return nil
+	}
+	if err := backoff.Retry(waitBlock, backoff.WithContext(backoff.NewConstantBackOff(relayerRetryDelay), ctx)); err != nil {
+		return hermes.ChainConfig{}, err
+	}
+
+	// The address prefix is the one of the relayer account created by the chain
+	commands, err := c.chain.Commands(ctx)
+	if err != nil {
+		return hermes.ChainConfig{}, err
+	}
+	account, err := commands.ShowAccount(ctx, w.config.Relayer.Account)
+	if err != nil {
+		return hermes.ChainConfig{}, err
+	}
+	prefix, err := cosmosutil.GetAddressPrefix(account.Address)
+	if err != nil {
+		return hermes.ChainConfig{}, err
+	}
+
+	price, err := relayerGasPrice(cfg)
+	if err != nil {
+		return hermes.ChainConfig{}, err
+	}
+	gasPrice, err := price.Amount.Float64()
+	if err != nil {
+		return hermes.ChainConfig{}, err
+	}
+
+	chainConfig := hermes.DefaultChainConfig(id, rpcAddr, grpcAddr, wsAddr+"/websocket")
+	chainConfig.AccountPrefix = prefix
+	chainConfig.KeyName = w.config.Relayer.Account
+	chainConfig.GasPrice = hermes.GasPrice{Price: gasPrice, Denom: price.Denom}
+	return chainConfig, nil
+}
+
+// isRelayed returns true when a channel is opened with the chain.
+func (w *Workspace) isRelayed(name string) bool {
+	for _, channel := range w.config.Relayer.Channels {
+		if channel.A.Chain == name || channel.B.Chain == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasChannel(ctx context.Context, h hermes.Hermes, chainID, counterpartyChainID, port string) (bool, error) {
+	channels, err := h.Channels(ctx, chainID, counterpartyChainID)
+	if err != nil {
+		return false, errors.Errorf("querying channels of chain %s: %w", chainID, err)
+	}
+	for _, c := range channels {
+		if c.PortID == port {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,57 @@
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+func TestAddRelayerAccount(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator chainconfig.Validator
+		accounts  []base.Account
+		want      []string
+		err       string
+	}{
+		{
+			name:      "bonded denom",
+			validator: chainconfig.Validator{Name: "alice", Bonded: "100000000stake"},
+			want:      []string{"100000000000stake"},
+		},
+		{
+			name: "minimum gas price denom",
+			validator: chainconfig.Validator{
+				Name:   "alice",
+				Bonded: "100000000stake",
+				App:    xyaml.Map{"minimum-gas-prices": "0.025token"},
+			},
+			want: []string{"100000000000token"},
+		},
+		{
+			name:      "existing account",
+			validator: chainconfig.Validator{Name: "alice", Bonded: "100000000stake"},
+			accounts:  []base.Account{{Name: "relayer"}},
+			err:       "account relayer is reserved for the relayer, change the relayer account of the workspace",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := chainconfig.DefaultChainConfig()
+			cfg.Accounts = tt.accounts
+			cfg.Validators = []chainconfig.Validator{tt.validator}
+
+			err := addRelayerAccount(cfg, "relayer", "mnemonic")
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, base.Account{Name: "relayer", Mnemonic: "mnemonic", Coins: tt.want}, cfg.Accounts[len(cfg.Accounts)-1])
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Package workspace serves several chains at once and relays the packets
+// of the channels opened between them.
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"golang.org/x/sync/errgroup"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	uilog "github.com/ignite/cli/v29/ignite/pkg/cliui/log"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+// portsIncrement is the increment of the default ports of each chain of the workspace.
+// The increment leaves room for the ports of the validators of each chain.
+const portsIncrement = 100
+
+type (
+	// Workspace serves the chains of a workspace config.
+	Workspace struct {
+		config       Config
+		chains       []workspaceChain
+		chainOptions []chain.Option
+		ev           events.Bus
+		logOutputer  uilog.Outputer
+		mnemonic     string
+	}
+
+	// Option configures the Workspace.
+	Option func(*Workspace)
+
+	workspaceChain struct {
+		Chain
+		chain *chain.Chain
+		ev    events.Bus
+	}
+)
+
+// CollectEvents collects the events of the workspace and its chains.
+func CollectEvents(ev events.Bus) Option {
+	return func(w *Workspace) {
+		w.ev = ev
+	}
+}
+
+// WithOutputer sets the CLI outputer for the workspace and its chains.
+func WithOutputer(o uilog.Outputer) Option {
+	return func(w *Workspace) {
+		w.logOutputer = o
+	}
+}
+
+// WithChainOptions adds options to create the chains of the workspace.
+func WithChainOptions(options ...chain.Option) Option {
+	return func(w *Workspace) {
+		w.chainOptions = append(w.chainOptions, options...)
+	}
+}
+
+// New creates a new workspace with the chains defined in the config.
+// The default ports of each chain are incremented to avoid port clashing
+// and, when channels are defined, a relayer account is added to the chains.
+func New(cfg Config, options ...Option) (*Workspace, error) {
+	w := &Workspace{config: cfg}
+	for _, apply := range options {
+		apply(w)
+	}
+
+	if w.hasRelayer() {
+		mnemonic, err := relayerMnemonic()
+		if err != nil {
+			return nil, err
+		}
+		w.mnemonic = mnemonic
+	}
+
+	var (
+		ids   = make(map[string]string)
+		homes = make(map[string]string)
+	)
+	for i, c := range cfg.Chains {
+		wc := workspaceChain{Chain: c, ev: events.NewBus()}
+
+		chainOptions := append(
+			slices.Clone(w.chainOptions),
+			chain.CollectEvents(wc.ev),
+			chain.ModifyConfig(w.modifyConfig(i)),
+		)
+		if w.logOutputer != nil {
+			chainOptions = append(chainOptions, chain.WithOutputer(w.logOutputer))
+		}
+		if c.Config != "" {
+			chainOptions = append(chainOptions, chain.ConfigFile(c.Config))
+		}
+		if c.Home != "" {
+			chainOptions = append(chainOptions, chain.HomePath(c.Home))
+		}
+
+		var err error
+		if wc.chain, err = chain.New(c.Path, chainOptions...); err != nil {
+			return nil, errors.Errorf("chain %s: %w", c.Name, err)
+		}
+
+		// Chains must not share their ID or their data directory
+		id, err := wc.chain.ID()
+		if err != nil {
+			return nil, errors.Errorf("chain %s: %w", c.Name, err)
+		}
+		if name, ok := ids[id]; ok {
+			return nil, errors.Errorf("chains %s and %s have the same chain ID %s", name, c.Name, id)
+		}
+		ids[id] = c.Name
+
+		home, err := wc.chain.Home()
+		if err != nil {
+			return nil, errors.Errorf("chain %s: %w", c.Name, err)
+		}
+		if name, ok := homes[home]; ok {
+			return nil, errors.Errorf("chains %s and %s have the same home %s, set the home of one of them", name, c.Name, home)
+		}
+		homes[home] = c.Name
+
+		w.chains = append(w.chains, wc)
+	}
+
+	return w, nil
+}
+
+// Serve serves the chains of the workspace and, once the chains are started,
+// opens the channels between them and relays their packets.
+func (w *Workspace) Serve(ctx context.Context, cacheStorage cache.Storage, options ...chain.ServeOption) error {
+	// the chains share the cache storage so their checksums are saved by home
+	options = append(slices.Clone(options), chain.ServeCacheByHome())
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	for _, c := range w.chains {
+		g.Go(func() error {
+			w.forwardEvents(ctx, c)
+			return nil
+		})
+		g.Go(func() error {
+			if err := c.chain.Serve(ctx, cacheStorage, options...); err != nil {
+				return errors.Errorf("chain %s: %w", c.Name, err)
+			}
+			return nil
+		})
+	}
+
+	if w.hasRelayer() {
+		g.Go(func() error {
+			return w.relay(ctx)
+		})
+	}
+
+	return g.Wait()
+}
+
+// modifyConfig returns a modifier for the config of the chain at an index of the workspace.
+func (w *Workspace) modifyConfig(index int) chain.ConfigModifier {
+	return func(cfg *chainconfig.Config) error {
+		if err := cfg.IncrementDefaultServerPortsBy(uint64(portsIncrement * index)); err != nil { //nolint:gosec // index is positive
+			return err
+		}
+
+		if !w.hasRelayer() {
+			return nil
+		}
+		return addRelayerAccount(cfg, w.config.Relayer.Account, w.mnemonic)
+	}
+}
+
+// forwardEvents sends the events of a chain to the workspace events prefixed with the chain name.
+func (w *Workspace) forwardEvents(ctx context.Context, c workspaceChain) {
+	prefix := colors.Name(fmt.Sprintf("[%s]", c.Name))
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-c.ev.Events():
+			w.ev.Send(fmt.Sprintf("%s %s", prefix, e.Message), func(ev *events.Event) {
+				ev.ProgressIndication = e.ProgressIndication
+				ev.Icon = e.Icon
+				ev.Indent = e.Indent
+				ev.Verbose = e.Verbose
+				ev.Group = e.Group
+			})
+		}
+	}
+}
+
+func (w *Workspace) hasRelayer() bool {
+	return len(w.config.Relayer.Channels) > 0
+}
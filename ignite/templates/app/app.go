@@ -70,6 +70,7 @@ func NewGenerator(opts *Options) (*genny.Generator, error) {
 	ctx.Set("AddressPrefix", opts.AddressPrefix)
 	ctx.Set("DepTools", cosmosgen.DepTools())
 	ctx.Set("IsChainMinimal", opts.IsChainMinimal)
+	ctx.Set("IsICAHost", opts.IsICAHost)
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(xgenny.Transformer(ctx))
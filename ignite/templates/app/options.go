@@ -12,4 +12,6 @@ type Options struct {
 	// IncludePrefixes is used to filter the files to include from the generator
 	IncludePrefixes []string
 	IsChainMinimal  bool
+	// IsICAHost is true if the interchain accounts of the counterparty chains can execute messages on the chain
+	IsICAHost bool
 }
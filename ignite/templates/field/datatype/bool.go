@@ -14,6 +14,8 @@ var DataBool = DataType{
 	DataType:                func(string) string { return "bool" },
 	CollectionsKeyValueName: func(string) string { return "collections.BoolKey" },
 	DefaultTestValue:        "false",
+	GoTestValue:             "true",
+	FuzzSeeds:               []string{"false", "true"},
 	ValueLoop:               "false",
 	ValueIndex:              "false",
 	ValueInvalidIndex:       "false",
@@ -14,6 +14,8 @@ var DataBytes = DataType{
 	DataType:                func(string) string { return "[]byte" },
 	CollectionsKeyValueName: func(string) string { return "collections.BytesKey" },
 	DefaultTestValue:        "[]byte{1, 2, 3, 4, 5}",
+	GoTestValue:             "[]byte{1, 2, 3, 4, 5}",
+	FuzzSeeds:               []string{"nil", "[]byte{1, 2, 3, 4, 5}", "[]byte{0xff}"},
 	ProtoType: func(_, name string, index int) string {
 		return fmt.Sprintf("bytes %s = %d", name, index)
 	},
@@ -15,6 +15,8 @@ var (
 		DataType:                func(string) string { return "sdk.Coin" },
 		CollectionsKeyValueName: func(string) string { return collectionValueComment },
 		DefaultTestValue:        "10token",
+		GoTestValue:             `sdk.NewInt64Coin("token", 10)`,
+		FuzzSeeds:               []string{"sdk.Coin{}", `sdk.NewInt64Coin("token", 10)`},
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("cosmos.base.v1beta1.Coin %s = %d [(gogoproto.nullable) = false]",
 				name, index)
@@ -26,9 +28,10 @@ var (
 						return err
 					}`, prefix, name.UpperCamel, argIndex)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
-		ProtoImports: []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
-		NonIndex:     true,
+		GoCLIImports:  []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		GoTestImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		ProtoImports:  []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
+		NonIndex:      true,
 		ToProtoField: func(_, name string, index int) *proto.NormalField {
 			option := protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())
 			return protoutil.NewField(
@@ -42,6 +45,8 @@ var (
 		DataType:                func(string) string { return "sdk.Coins" },
 		CollectionsKeyValueName: func(string) string { return collectionValueComment },
 		DefaultTestValue:        "10token,20stake",
+		GoTestValue:             `sdk.NewCoins(sdk.NewInt64Coin("token", 10), sdk.NewInt64Coin("stake", 20))`,
+		FuzzSeeds:               []string{"nil", `sdk.NewCoins(sdk.NewInt64Coin("token", 10), sdk.NewInt64Coin("stake", 20))`},
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated cosmos.base.v1beta1.Coin %s = %d [(gogoproto.nullable) = false]",
 				name, index)
@@ -53,9 +58,10 @@ var (
 						return err
 					}`, prefix, name.UpperCamel, argIndex)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
-		ProtoImports: []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
-		NonIndex:     true,
+		GoCLIImports:  []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		GoTestImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		ProtoImports:  []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
+		NonIndex:      true,
 		ToProtoField: func(_, name string, index int) *proto.NormalField {
 			option := protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())
 			return protoutil.NewField(
@@ -14,6 +14,8 @@ var DataCustom = DataType{
 	DataType:                func(datatype string) string { return fmt.Sprintf("*%s", datatype) },
 	CollectionsKeyValueName: func(string) string { return collectionValueComment },
 	DefaultTestValue:        "null",
+	GoTestValue:             "nil",
+	FuzzSeeds:               []string{"nil"},
 	ProtoType: func(datatype, name string, index int) string {
 		return fmt.Sprintf("%s %s = %d", datatype, name, index)
 	},
@@ -15,6 +15,8 @@ var (
 		DataType:                func(string) string { return "int64" },
 		CollectionsKeyValueName: func(string) string { return "collections.Int64Key" },
 		DefaultTestValue:        "111",
+		GoTestValue:             "111",
+		FuzzSeeds:               []string{"0", "111", "-9223372036854775808"},
 		ValueLoop:               "int64(i)",
 		ValueIndex:              "0",
 		ValueInvalidIndex:       "100000",
@@ -49,6 +51,8 @@ var (
 		DataType:                func(string) string { return "[]int64" },
 		CollectionsKeyValueName: func(string) string { return collectionValueComment },
 		DefaultTestValue:        "1,2,3,4,5",
+		GoTestValue:             "[]int64{1, 2, 3, 4, 5}",
+		FuzzSeeds:               []string{"nil", "[]int64{1, 2, 3, 4, 5}", "[]int64{-1}"},
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated int64 %s = %d", name, index)
 		},
@@ -15,6 +15,8 @@ var (
 		DataType:                func(string) string { return "string" },
 		CollectionsKeyValueName: func(string) string { return "collections.StringKey" },
 		DefaultTestValue:        "xyz",
+		GoTestValue:             `"xyz"`,
+		FuzzSeeds:               []string{`""`, `"xyz"`, `"\x00\xff"`},
 		ValueLoop:               "strconv.Itoa(i)",
 		ValueIndex:              "strconv.Itoa(0)",
 		ValueInvalidIndex:       "strconv.Itoa(100000)",
@@ -43,6 +45,8 @@ var (
 		DataType:                func(string) string { return "[]string" },
 		CollectionsKeyValueName: func(string) string { return collectionValueComment },
 		DefaultTestValue:        "abc,xyz",
+		GoTestValue:             `[]string{"abc", "xyz"}`,
+		FuzzSeeds:               []string{"nil", `[]string{"abc", "xyz"}`, `[]string{""}`},
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated string %s = %d", name, index)
 		},
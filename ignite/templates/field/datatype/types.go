@@ -84,7 +84,10 @@ type DataType struct {
 	GenesisArgs             func(name multiformatname.Name, value int) string
 	ProtoImports            []string
 	GoCLIImports            []GoImport
+	GoTestImports           []GoImport
 	DefaultTestValue        string
+	GoTestValue             string
+	FuzzSeeds               []string
 	ValueLoop               string
 	ValueIndex              string
 	ValueInvalidIndex       string
@@ -15,6 +15,8 @@ var (
 		DataType:                func(string) string { return "uint64" },
 		CollectionsKeyValueName: func(string) string { return "collections.Uint64Key" },
 		DefaultTestValue:        "111",
+		GoTestValue:             "111",
+		FuzzSeeds:               []string{"0", "111", "18446744073709551615"},
 		ValueLoop:               "uint64(i)",
 		ValueIndex:              "0",
 		ValueInvalidIndex:       "100000",
@@ -49,6 +51,8 @@ var (
 		DataType:                func(string) string { return "[]uint64" },
 		CollectionsKeyValueName: func(string) string { return collectionValueComment },
 		DefaultTestValue:        "1,2,3,4,5",
+		GoTestValue:             "[]uint64{1, 2, 3, 4, 5}",
+		FuzzSeeds:               []string{"nil", "[]uint64{1, 2, 3, 4, 5}", "[]uint64{18446744073709551615}"},
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated uint64 %s = %d", name, index)
 		},
@@ -59,6 +59,24 @@ func (f Field) DefaultTestValue() string {
 	return dt.DefaultTestValue
 }
 
+// GoTestValue returns the Datatype value used in Go tests.
+func (f Field) GoTestValue() string {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GoTestValue
+}
+
+// FuzzSeeds returns the Datatype values used to seed the corpus of fuzz tests.
+func (f Field) FuzzSeeds() []string {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.FuzzSeeds
+}
+
 // ValueLoop returns the Datatype value for loop iteration.
 func (f Field) ValueLoop() string {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
@@ -157,6 +175,15 @@ func (f Field) GoCLIImports() []datatype.GoImport {
 	return dt.GoCLIImports
 }
 
+// GoTestImports returns the Datatype imports for the test values and the fuzz seeds.
+func (f Field) GoTestImports() []datatype.GoImport {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GoTestImports
+}
+
 // ProtoImports returns the Datatype imports for proto files.
 func (f Field) ProtoImports() []string {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
@@ -26,6 +26,42 @@ func (f Fields) GoCLIImports() []datatype.GoImport {
 	return allImports
 }
 
+// GoTestImports returns all go imports of the test values and the fuzz seeds.
+func (f Fields) GoTestImports() []datatype.GoImport {
+	allImports := make([]datatype.GoImport, 0)
+	exist := make(map[string]struct{})
+	for _, fields := range f {
+		for _, goImport := range fields.GoTestImports() {
+			if _, ok := exist[goImport.Name]; ok {
+				continue
+			}
+			exist[goImport.Name] = struct{}{}
+			allImports = append(allImports, goImport)
+		}
+	}
+	return allImports
+}
+
+// FuzzSeeds returns the values of the fields to seed the corpus of fuzz tests.
+// Each seed has a value per field, in the order of the fields, and the
+// values of the fields with fewer seeds are repeated.
+func (f Fields) FuzzSeeds() [][]string {
+	var n int
+	for _, field := range f {
+		n = max(n, len(field.FuzzSeeds()))
+	}
+
+	seeds := make([][]string, n)
+	for i := range seeds {
+		seeds[i] = make([]string, len(f))
+		for j, field := range f {
+			values := field.FuzzSeeds()
+			seeds[i][j] = values[i%len(values)]
+		}
+	}
+	return seeds
+}
+
 // ProtoImports returns all proto imports.
 func (f Fields) ProtoImports() []string {
 	allImports := make([]string, 0)
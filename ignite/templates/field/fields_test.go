@@ -0,0 +1,20 @@
+package field
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldsFuzzSeeds(t *testing.T) {
+	fields, err := ParseFields([]string{"foo:bool", "bar:int", "baz:coin"}, noCheck)
+	require.NoError(t, err)
+
+	require.Equal(t, [][]string{
+		{"false", "0", "sdk.Coin{}"},
+		{"true", "111", `sdk.NewInt64Coin("token", 10)`},
+		{"false", "-9223372036854775808", "sdk.Coin{}"},
+	}, fields.FuzzSeeds())
+
+	require.Empty(t, Fields{}.FuzzSeeds())
+}
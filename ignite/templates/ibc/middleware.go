@@ -0,0 +1,315 @@
+package ibc
+
+import (
+	"embed"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	appanalysis "github.com/ignite/cli/v29/ignite/pkg/cosmosanalysis/app"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+)
+
+// middlewareSuffix is the suffix of the variables of the scaffolded middlewares in the IBC file.
+const middlewareSuffix = "IBCMiddleware"
+
+//go:embed files/middleware/* files/middleware/**/*
+var fsMiddleware embed.FS
+
+// ibcStack is the wiring of an IBC stack found in the IBC file.
+type ibcStack struct {
+	// keeper is the app keeper of the application at the bottom of the stack, which sends its packets.
+	keeper string
+
+	// ics4Wrapper is the ICS4 wrapper of the outermost layer of the stack.
+	ics4Wrapper string
+}
+
+// MiddlewareOptions are options to scaffold an IBC middleware.
+type MiddlewareOptions struct {
+	AppPath        string
+	ModulePath     string
+	IBCPath        string
+	MiddlewareName string
+	Route          appanalysis.IBCRoute
+}
+
+// NewMiddleware returns the generator to scaffold an IBC middleware that wraps the IBC stack of a route.
+func NewMiddleware(opts *MiddlewareOptions) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsMiddleware, "files/middleware/", opts.AppPath)
+	)
+
+	g.RunFn(ibcMiddlewareModify(opts))
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("middlewareName", opts.MiddlewareName)
+	ctx.Set("modulePath", opts.ModulePath)
+
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{middlewareName}}", opts.MiddlewareName))
+
+	return g, nil
+}
+
+// ibcMiddlewareModify wraps the IBC stack of the route with the middleware in the IBC file.
+// The middleware is the outermost layer of the stack on both sides: it receives the packets
+// first and passes them to the next inner layer, and it is the ICS4 wrapper of the application,
+// so it sends the packets of the application last through the ICS4 wrapper of the next inner layer.
+func ibcMiddlewareModify(opts *MiddlewareOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(opts.IBCPath)
+		if err != nil {
+			return err
+		}
+
+		// Import
+		content, err := xast.AppendImports(
+			f.String(),
+			xast.WithLastImport(fmt.Sprintf("%s/x/%s", opts.ModulePath, opts.MiddlewareName)),
+		)
+		if err != nil {
+			return err
+		}
+
+		middleware := opts.MiddlewareName + middlewareSuffix
+		stack, err := findIBCStack(content, opts.Route)
+		if err != nil {
+			return errors.Errorf("%s: %w", opts.IBCPath, err)
+		}
+
+		template := `// wrap the %[1]v IBC stack with the %[2]v middleware
+%[3]v := %[2]v.NewIBCMiddleware(%[4]v, %[5]v)
+%[6]v.WithICS4Wrapper(%[3]v)
+
+`
+		code := fmt.Sprintf(
+			template,
+			opts.Route.Port,
+			opts.MiddlewareName,
+			middleware,
+			opts.Route.Module,
+			stack.ics4Wrapper,
+			stack.keeper,
+		)
+
+		content, err = wrapIBCRoute(content, opts.Route, middleware, code)
+		if err != nil {
+			return errors.Errorf("%s: %w", opts.IBCPath, err)
+		}
+
+		return r.File(genny.NewFileS(opts.IBCPath, content))
+	}
+}
+
+// findIBCStack finds the wiring of the IBC stack of the route in the IBC file, following the
+// variables of the IBC modules and middlewares of the stack down to the application.
+func findIBCStack(content string, route appanalysis.IBCRoute) (ibcStack, error) {
+	fileSet := token.NewFileSet()
+	f, err := parser.ParseFile(fileSet, "", content, parser.ParseComments)
+	if err != nil {
+		return ibcStack{}, err
+	}
+
+	routeCall, err := findIBCRoute(f, route)
+	if err != nil {
+		return ibcStack{}, err
+	}
+
+	values := make(stackValues)
+	ast.Inspect(f, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+		if ident, ok := assign.Lhs[0].(*ast.Ident); ok {
+			values[ident.Name] = append(values[ident.Name], assign)
+		}
+		return true
+	})
+
+	module := routeCall.Args[1]
+	keeper, ok := values.keeper(module, routeCall.Pos())
+	if !ok {
+		return ibcStack{}, errors.Errorf(
+			"unknown IBC stack of the %s port: the app keeper of the application of %s is not found",
+			route.Port,
+			types.ExprString(module),
+		)
+	}
+
+	return ibcStack{
+		keeper:      keeper,
+		ics4Wrapper: values.ics4Wrapper(module, routeCall.Pos()),
+	}, nil
+}
+
+// stackValues are the assignments of the variables of the IBC file, in order.
+type stackValues map[string][]*ast.AssignStmt
+
+// value returns the last value assigned to the variable before the position and the position of the assignment.
+func (v stackValues) value(ident *ast.Ident, pos token.Pos) (ast.Expr, token.Pos, bool) {
+	assigns := v[ident.Name]
+	for i := len(assigns) - 1; i >= 0; i-- {
+		if assigns[i].Pos() < pos {
+			return assigns[i].Rhs[0], assigns[i].Pos(), true
+		}
+	}
+	return nil, token.NoPos, false
+}
+
+// keeper returns the app keeper of the application at the bottom of the IBC stack.
+// The application is created from its app keeper and each middleware wraps the next inner
+// layer given as first argument.
+func (v stackValues) keeper(expr ast.Expr, pos token.Pos) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		value, valuePos, ok := v.value(e, pos)
+		if !ok {
+			return "", false
+		}
+		return v.keeper(value, valuePos)
+	case *ast.CallExpr:
+		if len(e.Args) == 1 && isAppField(e.Args[0]) {
+			return types.ExprString(e.Args[0]), true
+		}
+		if len(e.Args) > 0 {
+			return v.keeper(e.Args[0], pos)
+		}
+	}
+	return "", false
+}
+
+// ics4Wrapper returns the ICS4 wrapper of the outermost layer of the IBC stack.
+// A scaffolded middleware is itself an ICS4 wrapper, the other middlewares, like the fee
+// middleware, send the packets through the app keeper given as second argument and an
+// application without middleware sends them through the channel keeper.
+func (v stackValues) ics4Wrapper(module ast.Expr, pos token.Pos) string {
+	if ident, ok := module.(*ast.Ident); ok {
+		if strings.HasSuffix(ident.Name, middlewareSuffix) {
+			return ident.Name
+		}
+		if value, _, ok := v.value(ident, pos); ok {
+			module = value
+		}
+	}
+	if call, ok := module.(*ast.CallExpr); ok && len(call.Args) == 2 && isAppField(call.Args[1]) {
+		return types.ExprString(call.Args[1])
+	}
+	return "app.IBCKeeper.ChannelKeeper"
+}
+
+// isAppField returns true if the expression is a field of the app, like app.TransferKeeper.
+func isAppField(expr ast.Expr) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && ident.Name == "app"
+}
+
+// findIBCRoute returns the call adding the route to the IBC router.
+func findIBCRoute(f *ast.File, route appanalysis.IBCRoute) (*ast.CallExpr, error) {
+	var routeCall *ast.CallExpr
+	err := xast.Inspect(f, func(n ast.Node) error {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return nil
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "AddRoute" || types.ExprString(call.Args[0]) != route.PortExpr {
+			return nil
+		}
+		routeCall = call
+		return xast.ErrStop
+	})
+	if err != nil {
+		return nil, err
+	}
+	if routeCall == nil {
+		return nil, errors.Errorf("the IBC route of the %s port is not found", route.Port)
+	}
+	return routeCall, nil
+}
+
+// wrapIBCRoute replaces the IBC module of the route with the middleware in the IBC router
+// and inserts the code that creates the middleware before the statement of the route.
+func wrapIBCRoute(content string, route appanalysis.IBCRoute, middleware, code string) (string, error) {
+	fileSet := token.NewFileSet()
+	f, err := parser.ParseFile(fileSet, "", content, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	routeCall, err := findIBCRoute(f, route)
+	if err != nil {
+		return "", err
+	}
+
+	// find the statement of the route in the function body
+	var routeStmt ast.Stmt
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || fn.Body.Pos() > routeCall.Pos() || fn.Body.End() < routeCall.End() {
+			continue
+		}
+		for _, stmt := range fn.Body.List {
+			if stmt.Pos() <= routeCall.Pos() && routeCall.End() <= stmt.End() {
+				routeStmt = stmt
+				break
+			}
+		}
+	}
+	if routeStmt == nil {
+		return "", errors.Errorf("the IBC route of the %s port must be added inside a function", route.Port)
+	}
+
+	var (
+		moduleStart = fileSet.Position(routeCall.Args[1].Pos()).Offset
+		moduleEnd   = fileSet.Position(routeCall.Args[1].End()).Offset
+		stmtStart   = fileSet.Position(routeStmt.Pos()).Offset
+	)
+
+	// the statement may be preceded by comments, the code is inserted before them
+	if group := commentGroupBefore(f, fileSet, routeStmt); group != nil {
+		stmtStart = fileSet.Position(group.Pos()).Offset
+	}
+
+	var b strings.Builder
+	b.WriteString(content[:stmtStart])
+	b.WriteString(code)
+	b.WriteString(content[stmtStart:moduleStart])
+	b.WriteString(middleware)
+	b.WriteString(content[moduleEnd:])
+
+	formatted, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
+
+// commentGroupBefore returns the comments on the lines right before the statement, nil if there is none.
+func commentGroupBefore(f *ast.File, fileSet *token.FileSet, stmt ast.Stmt) *ast.CommentGroup {
+	stmtLine := fileSet.Position(stmt.Pos()).Line
+	for _, group := range f.Comments {
+		if fileSet.Position(group.End()).Line == stmtLine-1 {
+			return group
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appanalysis "github.com/ignite/cli/v29/ignite/pkg/cosmosanalysis/app"
+)
+
+func TestWrapIBCRoute(t *testing.T) {
+	const content = `package app
+
+func (app *App) registerIBCModules() {
+	transferIBCModule := ibctransfer.NewIBCModule(app.TransferKeeper)
+
+	// Create static IBC router
+	ibcRouter := porttypes.NewRouter().
+		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
+
+	app.IBCKeeper.SetRouter(ibcRouter)
+}
+`
+
+	tests := []struct {
+		name    string
+		route   appanalysis.IBCRoute
+		want    string
+		wantErr string
+	}{
+		{
+			name: "wrap the route",
+			route: appanalysis.IBCRoute{
+				Port:     "transfer",
+				PortExpr: "ibctransfertypes.ModuleName",
+				Module:   "transferIBCModule",
+			},
+			want: `package app
+
+func (app *App) registerIBCModules() {
+	transferIBCModule := ibctransfer.NewIBCModule(app.TransferKeeper)
+
+	fooIBCMiddleware := foo.NewIBCMiddleware(transferIBCModule, app.IBCFeeKeeper)
+
+	// Create static IBC router
+	ibcRouter := porttypes.NewRouter().
+		AddRoute(ibctransfertypes.ModuleName, fooIBCMiddleware).
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
+
+	app.IBCKeeper.SetRouter(ibcRouter)
+}
+`,
+		},
+		{
+			name: "unknown route",
+			route: appanalysis.IBCRoute{
+				Port:     "wasm",
+				PortExpr: "wasmtypes.ModuleName",
+				Module:   "wasmIBCModule",
+			},
+			wantErr: "the IBC route of the wasm port is not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := "fooIBCMiddleware := foo.NewIBCMiddleware(transferIBCModule, app.IBCFeeKeeper)\n\n"
+			got, err := wrapIBCRoute(content, tt.route, "fooIBCMiddleware", code)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFindIBCStack(t *testing.T) {
+	const content = `package app
+
+func (app *App) registerIBCModules() {
+	transferIBCModule := ibcfee.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.IBCFeeKeeper)
+
+	icaControllerIBCModule := ibcfee.NewIBCMiddleware(
+		icacontroller.NewIBCMiddleware(app.ICAControllerKeeper),
+		app.IBCFeeKeeper,
+	)
+
+	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
+
+	// wrap the transfer IBC stack with the foo middleware
+	fooIBCMiddleware := foo.NewIBCMiddleware(transferIBCModule, app.IBCFeeKeeper)
+	app.TransferKeeper.WithICS4Wrapper(fooIBCMiddleware)
+
+	var wasmStack porttypes.IBCModule
+	wasmStack = wasm.NewIBCHandler(app.WasmKeeper)
+	wasmStack = ibcfee.NewIBCMiddleware(wasmStack, app.IBCFeeKeeper)
+
+	marsIBCModule := ibcfee.NewIBCMiddleware(marsmodule.NewIBCModule(app.appCodec, app.MarsKeeper), app.IBCFeeKeeper)
+
+	ibcRouter := porttypes.NewRouter().
+		AddRoute(ibctransfertypes.ModuleName, fooIBCMiddleware).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(wasmtypes.ModuleName, wasmStack).
+		AddRoute(marsmoduletypes.ModuleName, marsIBCModule)
+
+	app.IBCKeeper.SetRouter(ibcRouter)
+}
+`
+
+	tests := []struct {
+		name    string
+		route   appanalysis.IBCRoute
+		want    ibcStack
+		wantErr string
+	}{
+		{
+			name: "stack wrapped by a scaffolded middleware",
+			route: appanalysis.IBCRoute{
+				Port:     "transfer",
+				PortExpr: "ibctransfertypes.ModuleName",
+				Module:   "fooIBCMiddleware",
+			},
+			want: ibcStack{keeper: "app.TransferKeeper", ics4Wrapper: "fooIBCMiddleware"},
+		},
+		{
+			name: "stack with the fee middleware",
+			route: appanalysis.IBCRoute{
+				Port:     "icacontroller",
+				PortExpr: "icacontrollertypes.SubModuleName",
+				Module:   "icaControllerIBCModule",
+			},
+			want: ibcStack{keeper: "app.ICAControllerKeeper", ics4Wrapper: "app.IBCFeeKeeper"},
+		},
+		{
+			name: "stack without middleware",
+			route: appanalysis.IBCRoute{
+				Port:     "icahost",
+				PortExpr: "icahosttypes.SubModuleName",
+				Module:   "icaHostIBCModule",
+			},
+			want: ibcStack{keeper: "app.ICAHostKeeper", ics4Wrapper: "app.IBCKeeper.ChannelKeeper"},
+		},
+		{
+			name: "stack variable assigned for each layer",
+			route: appanalysis.IBCRoute{
+				Port:     "wasm",
+				PortExpr: "wasmtypes.ModuleName",
+				Module:   "wasmStack",
+			},
+			want: ibcStack{keeper: "app.WasmKeeper", ics4Wrapper: "app.IBCFeeKeeper"},
+		},
+		{
+			name: "unknown stack",
+			route: appanalysis.IBCRoute{
+				Port:     "mars",
+				PortExpr: "marsmoduletypes.ModuleName",
+				Module:   "marsIBCModule",
+			},
+			wantErr: "unknown IBC stack of the mars port: the app keeper of the application of marsIBCModule is not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findIBCStack(content, tt.route)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,6 +3,10 @@ package message
 import (
 	"embed"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -27,6 +31,12 @@ var (
 
 	//go:embed files/simapp/* files/simapp/**/*
 	fsSimapp embed.FS
+
+	//go:embed files/fuzz/* files/fuzz/**/*
+	fsFuzz embed.FS
+
+	//go:embed files/integration/* files/integration/**/*
+	fsIntegration embed.FS
 )
 
 func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
@@ -82,7 +92,162 @@ func NewGenerator(replacer placeholder.Replacer, opts *Options) (*genny.Generato
 			return nil, err
 		}
 	}
-	return g, Box(template, opts, g)
+	if err := Box(template, opts, g); err != nil {
+		return nil, err
+	}
+
+	// The fuzz test creates the keeper test fixture with a testing.TB, the fixture of the
+	// modules scaffolded before accepts a *testing.T and is rewritten to accept a testing.TB.
+	fuzz, err := keeperFixtureSupportsFuzz(opts)
+	if err != nil {
+		return nil, err
+	}
+	if fuzz {
+		g.RunFn(keeperFixtureModify(opts))
+		fuzzTemplate := xgenny.NewEmbedWalker(
+			fsFuzz,
+			"files/fuzz",
+			opts.AppPath,
+		)
+		if err := Box(fuzzTemplate, opts, g); err != nil {
+			return nil, err
+		}
+	}
+
+	// The benchmark is only scaffolded in apps with the integration test harness
+	if testutil.HasIntegration(opts.AppPath) {
+		integrationTemplate := xgenny.NewEmbedWalker(
+			fsIntegration,
+			"files/integration",
+			opts.AppPath,
+		)
+		if err := Box(integrationTemplate, opts, g); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+// keeperFixtureSupportsFuzz returns true if the initFixture function of the keeper tests of the module
+// accepts a testing.TB, or a *testing.T that can be replaced by a testing.TB, so the fixture can be
+// created in the fuzz tests.
+func keeperFixtureSupportsFuzz(opts *Options) (bool, error) {
+	content, err := os.ReadFile(keeperTestPath(opts))
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	fn, param, _, err := keeperFixtureParam(string(content))
+	if err != nil || fn == nil {
+		return false, err
+	}
+	switch {
+	case isTestingType(param, "TB"):
+		return true, nil
+	case isTestingTPointer(param):
+		return !usesTestingTMethods(fn), nil
+	default:
+		return false, nil
+	}
+}
+
+// keeperFixtureModify replaces the *testing.T param of the initFixture function of the keeper
+// tests of the module by a testing.TB, so the fixture can be created in the fuzz tests.
+func keeperFixtureModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := keeperTestPath(opts)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		fn, param, fileSet, err := keeperFixtureParam(f.String())
+		if err != nil {
+			return err
+		}
+		if fn == nil || !isTestingTPointer(param) {
+			return nil
+		}
+
+		content := f.String()
+		start, end := fileSet.Position(param.Pos()).Offset, fileSet.Position(param.End()).Offset
+		content = content[:start] + "testing.TB" + content[end:]
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperTestPath returns the path of the keeper tests file of the module.
+func keeperTestPath(opts *Options) string {
+	return filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper", "keeper_test.go")
+}
+
+// keeperFixtureParam returns the initFixture function of the keeper tests and the type of its
+// single param. The function is nil if it doesn't exist or doesn't accept a single param.
+func keeperFixtureParam(content string) (*ast.FuncDecl, ast.Expr, *token.FileSet, error) {
+	fileSet := token.NewFileSet()
+	f, err := parser.ParseFile(fileSet, "", content, parser.ParseComments)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "initFixture" || fn.Recv != nil {
+			continue
+		}
+		params := fn.Type.Params.List
+		if len(params) != 1 || len(params[0].Names) > 1 {
+			return nil, nil, fileSet, nil
+		}
+		return fn, params[0].Type, fileSet, nil
+	}
+	return nil, nil, fileSet, nil
+}
+
+// isTestingType returns true if the expression is the given type of the testing package.
+func isTestingType(expr ast.Expr, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "testing" && sel.Sel.Name == name
+}
+
+// isTestingTPointer returns true if the expression is a *testing.T.
+func isTestingTPointer(expr ast.Expr) bool {
+	star, ok := expr.(*ast.StarExpr)
+	return ok && isTestingType(star.X, "T")
+}
+
+// usesTestingTMethods returns true if the function calls a method of its *testing.T param
+// that is not part of the testing.TB interface.
+func usesTestingTMethods(fn *ast.FuncDecl) bool {
+	names := fn.Type.Params.List[0].Names
+	if len(names) == 0 {
+		return false
+	}
+	param := names[0].Name
+
+	var found bool
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return !found
+		}
+		if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == param {
+			switch sel.Sel.Name {
+			case "Run", "Parallel", "Deadline":
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
 }
 
 // protoTxRPCModify modifies the tx.proto file to add the required RPCs and messages.
@@ -0,0 +1,112 @@
+package message
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+)
+
+func TestKeeperFixture(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		fuzz     bool
+		modified string
+	}{
+		{
+			name: "testing.TB fixture",
+			content: `package keeper_test
+
+func initFixture(t testing.TB) *fixture {
+	return &fixture{}
+}
+`,
+			fuzz: true,
+			modified: `package keeper_test
+
+func initFixture(t testing.TB) *fixture {
+	return &fixture{}
+}
+`,
+		},
+		{
+			name: "testing.T fixture",
+			content: `package keeper_test
+
+// initFixture creates the fixture.
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
+	ctx := testutil.DefaultContextWithDB(t, storeKey, tKey).Ctx
+	return &fixture{ctx: ctx}
+}
+`,
+			fuzz: true,
+			modified: `package keeper_test
+
+// initFixture creates the fixture.
+func initFixture(t testing.TB) *fixture {
+	t.Helper()
+	ctx := testutil.DefaultContextWithDB(t, storeKey, tKey).Ctx
+	return &fixture{ctx: ctx}
+}
+`,
+		},
+		{
+			name: "testing.T fixture with subtests",
+			content: `package keeper_test
+
+func initFixture(t *testing.T) *fixture {
+	t.Run("init", func(t *testing.T) {})
+	return &fixture{}
+}
+`,
+			fuzz: false,
+		},
+		{
+			name: "no fixture",
+			content: `package keeper_test
+
+func TestKeeper(t *testing.T) {}
+`,
+			fuzz: false,
+		},
+		{
+			name: "no keeper tests",
+			fuzz: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				appPath = t.TempDir()
+				opts    = &Options{AppPath: appPath, ModuleName: "mars"}
+			)
+			if tt.content != "" {
+				require.NoError(t, os.MkdirAll(filepath.Dir(keeperTestPath(opts)), 0o755))
+				require.NoError(t, os.WriteFile(keeperTestPath(opts), []byte(tt.content), 0o644))
+			}
+
+			fuzz, err := keeperFixtureSupportsFuzz(opts)
+			require.NoError(t, err)
+			require.Equal(t, tt.fuzz, fuzz)
+			if !fuzz {
+				return
+			}
+
+			g := genny.New()
+			g.RunFn(keeperFixtureModify(opts))
+			_, err = xgenny.NewRunner(context.Background(), appPath).RunAndApply(g)
+			require.NoError(t, err)
+
+			content, err := os.ReadFile(keeperTestPath(opts))
+			require.NoError(t, err)
+			require.Equal(t, tt.modified, string(content))
+		})
+	}
+}
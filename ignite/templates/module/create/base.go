@@ -52,6 +52,7 @@ func NewGenerator(opts *CreateOptions) (*genny.Generator, error) {
 	ctx.Set("params", opts.Params)
 	ctx.Set("configs", opts.Configs)
 	ctx.Set("isIBC", opts.IsIBC)
+	ctx.Set("isICAController", opts.IsICAController)
 	ctx.Set("apiPath", fmt.Sprintf("/%s/%s/%s", appModulePath, opts.ModuleName, opts.ProtoVer))
 	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName, opts.ProtoVer))
 	ctx.Set("protoModulePkgName", module.ProtoModulePackageName(appModulePath, opts.ModuleName, opts.ProtoVer))
@@ -42,6 +42,7 @@ func NewIBC(replacer placeholder.Replacer, opts *CreateOptions) (*genny.Generato
 	ctx.Set("appName", opts.AppName)
 	ctx.Set("protoVer", opts.ProtoVer)
 	ctx.Set("ibcOrdering", opts.IBCOrdering)
+	ctx.Set("isICAController", opts.IsICAController)
 	ctx.Set("dependencies", opts.Dependencies)
 	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName, opts.ProtoVer))
 
@@ -0,0 +1,141 @@
+package modulecreate
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/pkg/xstrings"
+	"github.com/ignite/cli/v29/ignite/templates/module"
+)
+
+const (
+	// icaControllerMiddleware is the ICA controller middleware of the app template.
+	icaControllerMiddleware = "icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)"
+
+	// icaControllerKeeperGetter is the app method supplying the ICA controller keeper to the modules.
+	icaControllerKeeperGetter = "GetICAControllerKeeper"
+)
+
+// NewICAController returns the generator to scaffold the interchain accounts of a module
+// as the authentication module of the ICA controller.
+func NewICAController(opts *CreateOptions) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsICA, "files/ica/", opts.AppPath)
+	)
+
+	g.RunFn(appICAModify(opts))
+	g.RunFn(appIBCICAModify(opts))
+
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	return g, nil
+}
+
+// appICAModify supplies the ICA controller keeper to the modules in app.go.
+// The keeper is created with the IBC modules after the dependency injection,
+// so it is supplied as a function called once the app is built.
+func appICAModify(opts *CreateOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		if strings.Contains(content, icaControllerKeeperGetter) {
+			return nil
+		}
+
+		content, err = supplyICAControllerKeeper(content)
+		if err != nil {
+			return errors.Errorf("%s: %w", path, err)
+		}
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
+
+// supplyICAControllerKeeper adds the getter of the ICA controller keeper at the end of the
+// values supplied to the dependency injection in the New function of the app.
+func supplyICAControllerKeeper(content string) (string, error) {
+	content, err := xast.ModifyFunction(
+		content,
+		"New",
+		xast.AppendInsideFuncCall("Supply", fmt.Sprintf("\napp.%s", icaControllerKeeperGetter), -1),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	template := `// %[1]v returns the ICA controller keeper of the app.
+func (app *App) %[1]v() *icacontrollerkeeper.Keeper {
+	return &app.ICAControllerKeeper
+}`
+	return xast.AppendFunction(content, fmt.Sprintf(template, icaControllerKeeperGetter))
+}
+
+// appIBCICAModify sets the module as the authentication module of the ICA controller middleware
+// in ibc.go, so the acknowledgements and timeouts of its interchain accounts are routed to the module.
+func appIBCICAModify(opts *CreateOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathIBCConfigGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := replaceICAControllerMiddleware(f.String(), opts.ModuleName)
+		if err != nil {
+			return errors.Errorf("%s: %w", path, err)
+		}
+
+		// Import
+		content, err = xast.AppendImports(
+			content,
+			xast.WithLastNamedImport(
+				fmt.Sprintf("%[1]vmodule", opts.ModuleName),
+				fmt.Sprintf("%[1]v/x/%[2]v/module", opts.ModulePath, opts.ModuleName),
+			),
+		)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
+
+// replaceICAControllerMiddleware replaces the ICA controller middleware of the app template
+// with a middleware using the module as authentication module.
+func replaceICAControllerMiddleware(content, moduleName string) (string, error) {
+	replacement := fmt.Sprintf(
+		"icacontroller.NewIBCMiddlewareWithAuth(%[1]vmodule.NewICAControllerModule(app.%[2]vKeeper), app.ICAControllerKeeper)",
+		moduleName,
+		xstrings.Title(moduleName),
+	)
+	if strings.Contains(content, replacement) {
+		return content, nil
+	}
+	if !strings.Contains(content, icaControllerMiddleware) {
+		return "", errors.Errorf("the ICA controller middleware %s is not found", icaControllerMiddleware)
+	}
+	return strings.Replace(content, icaControllerMiddleware, replacement, 1), nil
+}
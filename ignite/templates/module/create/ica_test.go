@@ -0,0 +1,144 @@
+package modulecreate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupplyICAControllerKeeper(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		err     string
+	}{
+		{
+			name: "multiline supply with comments",
+			content: `package app
+
+func New(logger log.Logger, appOpts servertypes.AppOptions) *App {
+	var (
+		app       = &App{}
+		appConfig = depinject.Configs(
+			AppConfig(),
+			depinject.Supply(
+				appOpts, // supply app options
+				logger,  // supply logger
+				// here alternative options can be supplied to the DI container.
+				// read the depinject documentation.
+			),
+		)
+	)
+	return app
+}
+
+func AppConfig() depinject.Config {
+	return depinject.Configs(
+		appConfig,
+		depinject.Supply(
+			// supply custom module basics
+			map[string]module.AppModuleBasic{},
+		),
+	)
+}
+`,
+			want: `package app
+
+func New(logger log.Logger, appOpts servertypes.AppOptions) *App {
+	var (
+		app       = &App{}
+		appConfig = depinject.Configs(
+			AppConfig(),
+			depinject.Supply(
+				appOpts, // supply app options
+				logger,
+				app.GetICAControllerKeeper,
+
+				// supply logger
+				// here alternative options can be supplied to the DI container.
+				// read the depinject documentation.
+			),
+		)
+	)
+	return app
+}
+
+func AppConfig() depinject.Config {
+	return depinject.Configs(
+		appConfig,
+		depinject.Supply(
+			// supply custom module basics
+			map[string]module.AppModuleBasic{},
+		),
+	)
+}
+func (app *App) GetICAControllerKeeper() *icacontrollerkeeper.Keeper {
+	return &app.ICAControllerKeeper
+}
+`,
+		},
+		{
+			name: "single line supply",
+			content: `package app
+
+func New() {
+	appConfig := depinject.Configs(AppConfig(), depinject.Supply(appOpts, logger))
+}
+`,
+			want: `package app
+
+func New() {
+	appConfig := depinject.Configs(AppConfig(), depinject.Supply(appOpts, logger,
+		app.GetICAControllerKeeper,
+	))
+}
+func (app *App) GetICAControllerKeeper() *icacontrollerkeeper.Keeper {
+	return &app.ICAControllerKeeper
+}
+`,
+		},
+		{
+			name: "no supply",
+			content: `package app
+
+func New() {}
+`,
+			err: "function calls not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := supplyICAControllerKeeper(tt.content)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReplaceICAControllerMiddleware(t *testing.T) {
+	content := `icaControllerIBCModule := ibcfee.NewIBCMiddleware(
+	icacontroller.NewIBCMiddleware(app.ICAControllerKeeper),
+	app.IBCFeeKeeper,
+)`
+	want := `icaControllerIBCModule := ibcfee.NewIBCMiddleware(
+	icacontroller.NewIBCMiddlewareWithAuth(foomodule.NewICAControllerModule(app.FooKeeper), app.ICAControllerKeeper),
+	app.IBCFeeKeeper,
+)`
+
+	got, err := replaceICAControllerMiddleware(content, "foo")
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	// the replacement is idempotent as the generators can run more than once
+	got, err = replaceICAControllerMiddleware(got, "foo")
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = replaceICAControllerMiddleware(want, "bar")
+	require.Error(t, err)
+}
@@ -0,0 +1,40 @@
+package modulecreate
+
+import (
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/v29/ignite/templates/testutil"
+)
+
+// NewIntegrationTests returns the generator to scaffold the integration test suite of a module,
+// which runs the module in the in-process app booted by the integration test harness.
+func NewIntegrationTests(opts *CreateOptions) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsIntegration, "files/integration/", opts.AppPath)
+	)
+
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+	// The harness is shared by the modules of the app, it is only created when missing
+	// to keep the changes made to it
+	if !testutil.HasIntegration(opts.AppPath) {
+		if err := testutil.RegisterIntegration(g, opts.AppPath); err != nil {
+			return g, err
+		}
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+
+	return g, nil
+}
@@ -71,8 +71,14 @@ type CreateOptions struct {
 	// Channel ordering of the IBC module: ordered, unordered or none
 	IBCOrdering string
 
+	// True if the module should register interchain accounts with the ICA controller
+	IsICAController bool
+
 	// Dependencies of the module
 	Dependencies Dependencies
+
+	// True if the module should have an integration test suite
+	IntegrationTests bool
 }
 
 // ProtoFile returns the path to the proto folder.
@@ -11,6 +11,12 @@ var (
 	//go:embed files/ibc/* files/ibc/**/*
 	fsIBC embed.FS
 
+	//go:embed files/ica/* files/ica/**/*
+	fsICA embed.FS
+
 	//go:embed files/msgserver/* files/msgserver/**/*
 	fsMsgServer embed.FS
+
+	//go:embed files/integration/* files/integration/**/*
+	fsIntegration embed.FS
 )
@@ -0,0 +1,74 @@
+package modulecreate
+
+import (
+	"embed"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/gobuffalo/plush/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+)
+
+func TestKeeperFixture(t *testing.T) {
+	tests := []struct {
+		name            string
+		fs              embed.FS
+		path            string
+		isICAController bool
+	}{
+		{
+			name: "base module",
+			fs:   fsBase,
+			path: "files/base/x/{{moduleName}}/keeper/keeper_test.go.plush",
+		},
+		{
+			name: "ibc module",
+			fs:   fsIBC,
+			path: "files/ibc/x/{{moduleName}}/keeper/keeper_test.go.plush",
+		},
+		{
+			name:            "ica controller module",
+			fs:              fsIBC,
+			path:            "files/ibc/x/{{moduleName}}/keeper/keeper_test.go.plush",
+			isICAController: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := tt.fs.ReadFile(tt.path)
+			require.NoError(t, err)
+
+			ctx := plush.NewContext()
+			ctx.Set("moduleName", "mars")
+			ctx.Set("modulePath", "github.com/test/mars")
+			ctx.Set("isICAController", tt.isICAController)
+			ctx.Set("dependencies", Dependencies{})
+			plushhelpers.ExtendPlushContext(ctx)
+
+			content, err := plush.Render(string(template), ctx)
+			require.NoError(t, err)
+
+			f, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+			require.NoError(t, err)
+
+			// The fixture accepts a testing.TB to be created in the fuzz tests of the messages
+			var param ast.Expr
+			for _, decl := range f.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == "initFixture" {
+					require.Len(t, fn.Type.Params.List, 1)
+					param = fn.Type.Params.List[0].Type
+				}
+			}
+			require.NotNil(t, param, "initFixture not found")
+
+			sel, ok := param.(*ast.SelectorExpr)
+			require.True(t, ok)
+			require.Equal(t, "testing", sel.X.(*ast.Ident).Name)
+			require.Equal(t, "TB", sel.Sel.Name)
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package query
 
 import (
+	"fmt"
 	"path/filepath"
 
 	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/v29/ignite/templates/field"
+	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
 )
 
 // Options ...
@@ -20,6 +22,54 @@ type Options struct {
 	ResFields   field.Fields
 	ReqFields   field.Fields
 	Paginated   bool
+	HTTPPath    string
+	Store       *Store
+}
+
+// Store describes a map or a list scaffolded in the module that a query is bound to.
+type Store struct {
+	TypeName multiformatname.Name
+	Index    field.Field
+	Filters  []Filter
+}
+
+// Filter is a request field used to filter the items of the store.
+type Filter struct {
+	Field field.Field
+	// OnIndex is true when the request field filters the index of the items
+	// instead of one of their fields.
+	OnIndex bool
+}
+
+// Condition returns the Go condition that is true when an item of the store
+// must be filtered out. The zero value of the request field disables the filter.
+// Items are filtered by prefix when the filter is on a string index.
+func (f Filter) Condition() string {
+	var (
+		req  = "req." + f.Field.Name.UpperCamel
+		zero = "0"
+	)
+	if f.Field.DatatypeName == datatype.String {
+		zero = `""`
+	}
+	switch {
+	case f.OnIndex && f.Field.DatatypeName == datatype.String:
+		return fmt.Sprintf("%s != %s && !strings.HasPrefix(key, %s)", req, zero, req)
+	case f.OnIndex:
+		return fmt.Sprintf("%s != %s && key != %s", req, zero, req)
+	default:
+		return fmt.Sprintf("%s != %s && value.%s != %s", req, zero, f.Field.Name.UpperCamel, req)
+	}
+}
+
+// HasPrefixFilter returns true if one of the filters is a prefix filter on the index.
+func (s Store) HasPrefixFilter() bool {
+	for _, f := range s.Filters {
+		if f.OnIndex && f.Field.DatatypeName == datatype.String {
+			return true
+		}
+	}
+	return false
 }
 
 // ProtoFile returns the path to the proto folder.
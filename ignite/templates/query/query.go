@@ -19,8 +19,13 @@ import (
 	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
 )
 
-//go:embed files/* files/**/*
-var fs embed.FS
+var (
+	//go:embed files/default/* files/default/**/*
+	fsDefault embed.FS
+
+	//go:embed files/store/* files/store/**/*
+	fsStore embed.FS
+)
 
 func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	if err := g.Box(box); err != nil {
@@ -36,6 +41,7 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("ReqFields", opts.ReqFields)
 	ctx.Set("ResFields", opts.ResFields)
 	ctx.Set("Paginated", opts.Paginated)
+	ctx.Set("Store", opts.Store)
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(xgenny.Transformer(ctx))
@@ -47,16 +53,25 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	return nil
 }
 
-// NewGenerator returns the generator to scaffold a empty query in a module.
+// NewGenerator returns the generator to scaffold a query in a module.
+// The query is empty unless it is bound to a store, in which case the items
+// of the store are looked up, filtered and paginated.
 func NewGenerator(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
 	var (
 		g        = genny.New()
 		template = xgenny.NewEmbedWalker(
-			fs,
-			"files/",
+			fsDefault,
+			"files/default/",
 			opts.AppPath,
 		)
 	)
+	if opts.Store != nil {
+		template = xgenny.NewEmbedWalker(
+			fsStore,
+			"files/store/",
+			opts.AppPath,
+		)
+	}
 
 	g.RunFn(protoQueryModify(opts))
 	g.RunFn(cliQueryModify(replacer, opts))
@@ -80,18 +95,12 @@ func protoQueryModify(opts *Options) genny.RunFn {
 			return err
 		}
 
-		// if the query has request fields, they are appended to the rpc query
-		var requestPath string
-		for _, field := range opts.ReqFields {
-			requestPath += "/"
-			requestPath = filepath.Join(requestPath, fmt.Sprintf("{%s}", field.ProtoFieldName()))
-		}
 		serviceQuery, err := protoutil.GetServiceByName(protoFile, "Query")
 		if err != nil {
 			return errors.Errorf("failed while looking up service 'Query' in %s: %w", path, err)
 		}
 
-		typenameUpper, appModulePath := opts.QueryName.UpperCamel, gomodulepath.ExtractAppPath(opts.ModulePath)
+		typenameUpper := opts.QueryName.UpperCamel
 		rpcSingle := protoutil.NewRPC(
 			typenameUpper,
 			fmt.Sprintf("Query%sRequest", typenameUpper),
@@ -99,10 +108,7 @@ func protoQueryModify(opts *Options) genny.RunFn {
 			protoutil.WithRPCOptions(
 				protoutil.NewOption(
 					"google.api.http",
-					fmt.Sprintf(
-						"/%s/%s/%s%s",
-						appModulePath, opts.ModuleName, opts.QueryName.Snake, requestPath,
-					),
+					httpPath(opts),
 					protoutil.Custom(),
 					protoutil.SetField("get"),
 				),
@@ -122,13 +128,22 @@ func protoQueryModify(opts *Options) genny.RunFn {
 		}
 		requestMessage := protoutil.NewMessage("Query"+typenameUpper+"Request", protoutil.WithFields(reqFields...))
 
-		// Fields for response
+		// Fields for response, a query bound to a store returns the filtered items
 		var resFields []*proto.NormalField
 		for i, field := range opts.ResFields {
 			resFields = append(resFields, field.ToProtoField(i+1))
 		}
+		if opts.Store != nil {
+			resFields = append(resFields, protoutil.NewField(
+				opts.Store.TypeName.LowerCamel,
+				opts.Store.TypeName.UpperCamel,
+				len(opts.ResFields)+1,
+				protoutil.Repeated(),
+				protoutil.WithFieldOptions(protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())),
+			))
+		}
 		if opts.Paginated {
-			resFields = append(resFields, protoutil.NewField(paginationName, paginationType+"Response", len(opts.ResFields)+1))
+			resFields = append(resFields, protoutil.NewField(paginationName, paginationType+"Response", len(resFields)+1))
 		}
 		responseMessage := protoutil.NewMessage("Query"+typenameUpper+"Response", protoutil.WithFields(resFields...))
 		protoutil.Append(protoFile, requestMessage, responseMessage)
@@ -142,6 +157,10 @@ func protoQueryModify(opts *Options) genny.RunFn {
 			protoPath := fmt.Sprintf("%[1]v/%[2]v/%[3]v/%[4]v.proto", opts.AppName, opts.ModuleName, opts.ProtoVer, f)
 			protoImports = append(protoImports, protoutil.NewImport(protoPath))
 		}
+		if opts.Store != nil {
+			protoPath := fmt.Sprintf("%[1]v/%[2]v/%[3]v/%[4]v.proto", opts.AppName, opts.ModuleName, opts.ProtoVer, opts.Store.TypeName.Snake)
+			protoImports = append(protoImports, protoutil.NewImport("gogoproto/gogo.proto"), protoutil.NewImport(protoPath))
+		}
 		if err = protoutil.AddImports(protoFile, true, protoImports...); err != nil {
 			return errors.Errorf("failed to add imports to %s: %w", path, err)
 		}
@@ -151,6 +170,27 @@ func protoQueryModify(opts *Options) genny.RunFn {
 	}
 }
 
+// httpPath returns the REST path template of the query. By default, the request
+// fields are appended to the path as path params, except for a query bound to a
+// store where the request fields are optional filters passed as query params.
+func httpPath(opts *Options) string {
+	if opts.HTTPPath != "" {
+		return opts.HTTPPath
+	}
+
+	var requestPath string
+	if opts.Store == nil {
+		for _, field := range opts.ReqFields {
+			requestPath += "/"
+			requestPath = filepath.Join(requestPath, fmt.Sprintf("{%s}", field.ProtoFieldName()))
+		}
+	}
+	return fmt.Sprintf(
+		"/%s/%s/%s%s",
+		gomodulepath.ExtractAppPath(opts.ModulePath), opts.ModuleName, opts.QueryName.Snake, requestPath,
+	)
+}
+
 func cliQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
 	return func(r *genny.Runner) error {
 		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module/autocli.go")
@@ -0,0 +1,214 @@
+package remove
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xstrings"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+const (
+	protoServiceMsg   = "Msg"
+	protoServiceQuery = "Query"
+)
+
+// component describes the code scaffolded for a component, it mirrors the
+// insertions made by the templates of the component.
+type component struct {
+	// files are the files created for the component, relative to the module.
+	files []string
+	// protoFiles are the files created for the component, relative to the module proto folder.
+	protoFiles []string
+	// protos are the proto insertions of the component indexed by proto file name.
+	protos map[string]protoChanges
+	// code are the Go insertions of the component indexed by path relative to the module.
+	code map[string]codeChanges
+}
+
+// protoChanges describes the insertions made in a proto file.
+type protoChanges struct {
+	service  string
+	rpcs     []string
+	messages []string
+	fields   map[string][]string
+	imports  []string
+}
+
+// codeChanges describes the insertions made in a Go file, see xast.RemoveReferences.
+type codeChanges struct {
+	idents      []string
+	fieldValues []string
+}
+
+// newComponent returns the description of the code scaffolded for the component.
+func newComponent(opts *Options) (component, error) {
+	var (
+		upper     = opts.Name.UpperCamel
+		lower     = opts.Name.LowerCamel
+		snake     = opts.Name.Snake
+		typeProto = fmt.Sprintf("%s/%s/%s/%s.proto", opts.AppName, opts.ModuleName, opts.ProtoVer, snake)
+		crudMsgs  = []string{"MsgCreate" + upper, "MsgUpdate" + upper, "MsgDelete" + upper}
+		crudRPCs  = []string{"Create" + upper, "Update" + upper, "Delete" + upper}
+	)
+
+	switch opts.Kind {
+	case KindList, KindMap, KindSingle:
+		c := component{
+			files: []string{
+				"keeper/query_" + snake + ".go",
+				"keeper/query_" + snake + "_test.go",
+				"keeper/msg_server_" + snake + ".go",
+				"keeper/msg_server_" + snake + "_test.go",
+				"types/messages_" + snake + ".go",
+				"types/" + snake + ".pb.go",
+				"simulation/" + snake + ".go",
+			},
+			protoFiles: []string{snake + ".proto"},
+			protos: map[string]protoChanges{
+				"tx.proto": {
+					service:  protoServiceMsg,
+					rpcs:     crudRPCs,
+					messages: withResponses(crudMsgs...),
+					imports:  []string{typeProto},
+				},
+			},
+			code: map[string]codeChanges{
+				"types/codec.go": {idents: crudMsgs},
+			},
+		}
+
+		// a single has no list query.
+		queryRPCs := []string{"Get" + upper}
+		queryMsgs := []string{"QueryGet" + upper + "Request", "QueryGet" + upper + "Response"}
+		if opts.Kind != KindSingle {
+			queryRPCs = append(queryRPCs, "List"+upper)
+			queryMsgs = append(queryMsgs, "QueryAll"+upper+"Request", "QueryAll"+upper+"Response")
+		}
+		c.protos["query.proto"] = protoChanges{
+			service:  protoServiceQuery,
+			rpcs:     queryRPCs,
+			messages: queryMsgs,
+			imports:  []string{typeProto},
+		}
+		c.code["module/autocli.go"] = codeChanges{fieldValues: append(queryRPCs, crudRPCs...)}
+
+		var genesisIdents, genesisFields []string
+		switch opts.Kind {
+		case KindList:
+			genesisIdents = []string{upper + "List", upper + "Count"}
+			genesisFields = []string{lower + "List", lower + "Count"}
+			c.code["keeper/keeper.go"] = codeChanges{idents: []string{upper, upper + "Seq"}}
+			c.code["keeper/genesis.go"] = codeChanges{idents: append([]string{upper, upper + "Seq"}, genesisIdents...)}
+			c.code["types/keys.go"] = codeChanges{idents: []string{upper + "Key", upper + "CountKey"}}
+			c.code["types/genesis_test.go"] = codeChanges{
+				idents:      genesisIdents,
+				fieldValues: []string{"duplicated " + lower, "invalid " + lower + " count"},
+			}
+		case KindMap:
+			genesisIdents = []string{upper + "List"}
+			genesisFields = []string{lower + "List"}
+			c.files = append(c.files, "types/key_"+snake+".go")
+			c.code["keeper/keeper.go"] = codeChanges{idents: []string{upper}}
+			c.code["keeper/genesis.go"] = codeChanges{idents: append([]string{upper}, genesisIdents...)}
+			c.code["types/genesis_test.go"] = codeChanges{
+				idents:      genesisIdents,
+				fieldValues: []string{"duplicated " + lower},
+			}
+		case KindSingle:
+			genesisIdents = []string{upper}
+			genesisFields = []string{lower}
+			c.code["keeper/keeper.go"] = codeChanges{idents: []string{upper}}
+			c.code["keeper/genesis.go"] = codeChanges{idents: genesisIdents}
+			c.code["types/keys.go"] = codeChanges{idents: []string{upper + "Key"}}
+			c.code["types/genesis_test.go"] = codeChanges{idents: genesisIdents}
+		}
+		c.protos["genesis.proto"] = protoChanges{
+			fields:  map[string][]string{typed.ProtoGenesisStateMessage: genesisFields},
+			imports: []string{typeProto},
+		}
+		c.code["keeper/genesis_test.go"] = codeChanges{idents: genesisIdents}
+		c.code["module/simulation.go"] = codeChanges{idents: genesisIdents}
+		c.code["types/genesis.go"] = codeChanges{idents: genesisIdents}
+		return c, nil
+
+	case KindMessage:
+		return component{
+			files: []string{
+				"keeper/msg_server_" + snake + ".go",
+				"keeper/msg_server_" + snake + "_fuzz_test.go",
+				"types/message_" + snake + ".go",
+				"simulation/" + snake + ".go",
+				"integration/bench_" + snake + "_test.go",
+			},
+			protos: map[string]protoChanges{
+				"tx.proto": {
+					service:  protoServiceMsg,
+					rpcs:     []string{upper},
+					messages: withResponses("Msg" + upper),
+				},
+			},
+			code: map[string]codeChanges{
+				"module/autocli.go": {fieldValues: []string{upper}},
+				"types/codec.go":    {idents: []string{"Msg" + upper}},
+			},
+		}, nil
+
+	case KindQuery:
+		return component{
+			files: []string{"keeper/query_" + snake + ".go", "keeper/query_" + snake + "_test.go"},
+			protos: map[string]protoChanges{
+				"query.proto": {
+					service:  protoServiceQuery,
+					rpcs:     []string{upper},
+					messages: []string{"Query" + upper + "Request", "Query" + upper + "Response"},
+				},
+			},
+			code: map[string]codeChanges{
+				"module/autocli.go": {fieldValues: []string{upper}},
+			},
+		}, nil
+
+	case KindPacket:
+		packetData := xstrings.Title(opts.ModuleName) + "PacketData"
+		return component{
+			files: []string{
+				"keeper/" + snake + ".go",
+				"types/packet_" + snake + ".go",
+				"types/messages_" + snake + ".go",
+				"keeper/msg_server_" + snake + ".go",
+				"keeper/msg_server_" + snake + "_test.go",
+				"client/cli/tx_" + snake + ".go",
+			},
+			protos: map[string]protoChanges{
+				"packet.proto": {
+					messages: []string{upper + "PacketData", upper + "PacketAck"},
+					fields:   map[string][]string{packetData: {lower + "Packet"}},
+				},
+				"tx.proto": {
+					service:  protoServiceMsg,
+					rpcs:     []string{"Send" + upper},
+					messages: withResponses("MsgSend" + upper),
+				},
+			},
+			code: map[string]codeChanges{
+				"module/module_ibc.go": {idents: []string{packetData + "_" + upper + "Packet"}},
+				"types/events_ibc.go":  {idents: []string{"EventType" + upper + "Packet"}},
+				"client/cli/tx.go":     {idents: []string{"CmdSend" + upper}},
+				"types/codec.go":       {idents: []string{"MsgSend" + upper}},
+			},
+		}, nil
+
+	default:
+		return component{}, errors.Errorf("%w %q", errUnknownKind, opts.Kind)
+	}
+}
+
+// withResponses returns the message names followed by their response message names.
+func withResponses(names ...string) []string {
+	messages := make([]string, 0, len(names)*2)
+	for _, name := range names {
+		messages = append(messages, name, name+"Response")
+	}
+	return messages
+}
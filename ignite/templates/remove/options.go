@@ -0,0 +1,43 @@
+package remove
+
+import (
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+)
+
+// Kind is the kind of scaffolded component to remove.
+type Kind string
+
+const (
+	KindList    Kind = "list"
+	KindMap     Kind = "map"
+	KindSingle  Kind = "single"
+	KindMessage Kind = "message"
+	KindQuery   Kind = "query"
+	KindPacket  Kind = "packet"
+)
+
+var errUnknownKind = errors.New("unknown component kind")
+
+// Options ...
+type Options struct {
+	AppName    string
+	AppPath    string
+	ProtoDir   string
+	ProtoVer   string
+	ModuleName string
+	Kind       Kind
+	Name       multiformatname.Name
+}
+
+// ProtoFile returns the path to the proto folder within the generated app.
+func (opts *Options) ProtoFile(fname string) string {
+	return filepath.Join(opts.AppPath, opts.ProtoDir, opts.AppName, opts.ModuleName, opts.ProtoVer, fname)
+}
+
+// ModuleFile returns the path to a file of the module within the generated app.
+func (opts *Options) ModuleFile(fname string) string {
+	return filepath.Join(opts.AppPath, "x", opts.ModuleName, fname)
+}
@@ -0,0 +1,181 @@
+package remove
+
+import (
+	"os"
+	"sort"
+
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+// NewGenerator returns the generator to undo the insertions made in the existing files
+// when the component was scaffolded.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	c, err := newComponent(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	g := genny.New()
+	for _, name := range sortedKeys(c.protos) {
+		g.RunFn(protoModify(opts.ProtoFile(name), c.protos[name]))
+	}
+	for _, name := range sortedKeys(c.code) {
+		g.RunFn(codeModify(opts.ModuleFile(name), c.code[name]))
+	}
+	return g, nil
+}
+
+// Files returns the paths of the existing files created when the component was scaffolded.
+func Files(opts *Options) ([]string, error) {
+	c, err := newComponent(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(c.files)+len(c.protoFiles))
+	for _, name := range c.protoFiles {
+		paths = append(paths, opts.ProtoFile(name))
+	}
+	for _, name := range c.files {
+		paths = append(paths, opts.ModuleFile(name))
+	}
+
+	files := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if _, err := os.Stat(path); err == nil {
+			files = append(files, path)
+		}
+	}
+	return files, nil
+}
+
+// Exists returns true if a component of the kind and the name of the options is scaffolded
+// in the module. The check relies on the proto messages defined for the component.
+func Exists(opts *Options) (bool, error) {
+	var (
+		upper     = opts.Name.UpperCamel
+		queryFile = opts.ProtoFile("query.proto")
+	)
+	switch opts.Kind {
+	case KindList, KindMap:
+		if !hasMessage(queryFile, "QueryAll"+upper+"Request") {
+			return false, nil
+		}
+		// a list has a counter in the genesis state.
+		f, err := protoutil.ParseProtoPath(opts.ProtoFile("genesis.proto"))
+		if err != nil {
+			return false, err
+		}
+		hasCount := protoutil.HasField(f, typed.ProtoGenesisStateMessage, opts.Name.LowerCamel+"Count")
+		return hasCount == (opts.Kind == KindList), nil
+	case KindSingle:
+		return hasMessage(queryFile, "QueryGet"+upper+"Request") &&
+			!hasMessage(queryFile, "QueryAll"+upper+"Request"), nil
+	case KindMessage:
+		return hasMessage(opts.ProtoFile("tx.proto"), "Msg"+upper), nil
+	case KindQuery:
+		return hasMessage(queryFile, "Query"+upper+"Request"), nil
+	case KindPacket:
+		return hasMessage(opts.ProtoFile("packet.proto"), upper+"PacketData"), nil
+	default:
+		return false, errors.Errorf("%w %q", errUnknownKind, opts.Kind)
+	}
+}
+
+// protoModify removes the RPCs, messages, fields and imports of the component from a proto file.
+func protoModify(path string, changes protoChanges) genny.RunFn {
+	return func(r *genny.Runner) error {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil
+		}
+		// the file is read from the disk instead of the runner to keep
+		// the unmodified files out of the runner results.
+		protoFile, err := protoutil.ParseProtoPath(path)
+		if err != nil {
+			return err
+		}
+
+		modified := false
+		if changes.service != "" {
+			if service, err := protoutil.GetServiceByName(protoFile, changes.service); err == nil {
+				for _, rpc := range changes.rpcs {
+					modified = protoutil.RemoveRPC(service, rpc) || modified
+				}
+			}
+		}
+		for _, message := range changes.messages {
+			modified = protoutil.RemoveMessage(protoFile, message) || modified
+		}
+		for _, name := range sortedKeys(changes.fields) {
+			message, err := protoutil.GetMessageByName(protoFile, name)
+			if err != nil {
+				continue
+			}
+			for _, field := range changes.fields[name] {
+				modified = protoutil.RemoveField(message, field) || modified
+			}
+		}
+		for _, imp := range changes.imports {
+			modified = protoutil.RemoveImport(protoFile, imp) || modified
+		}
+		if !modified {
+			return nil
+		}
+
+		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+		return r.File(newFile)
+	}
+}
+
+// codeModify removes the code referring to the component from a Go file.
+func codeModify(path string, changes codeChanges) genny.RunFn {
+	return func(r *genny.Runner) error {
+		// the file is read from the disk instead of the runner to keep
+		// the unmodified files out of the runner results.
+		content, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		newContent, err := xast.RemoveReferences(
+			string(content),
+			xast.WithIdents(changes.idents...),
+			xast.WithFieldValues(changes.fieldValues...),
+		)
+		if err != nil {
+			return errors.Errorf("error while processing %s: %w", path, err)
+		}
+		if newContent == string(content) {
+			return nil
+		}
+
+		newFile := genny.NewFileS(path, newContent)
+		return r.File(newFile)
+	}
+}
+
+// hasMessage returns true if the proto file exists and defines the message.
+func hasMessage(path, name string) bool {
+	f, err := protoutil.ParseProtoPath(path)
+	if err != nil {
+		return false
+	}
+	return protoutil.HasMessage(f, name)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,169 @@
+package remove
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+)
+
+const (
+	testGenesisProto = `syntax = "proto3";
+package mars.mars.v1;
+
+message GenesisState {
+  repeated Post postList = 1;
+  uint64 postCount = 2;
+  repeated Author authorList = 3;
+}
+`
+	testQueryProto = `syntax = "proto3";
+package mars.mars.v1;
+
+message QueryGetPostRequest {}
+message QueryAllPostRequest {}
+message QueryGetAuthorRequest {}
+message QueryAllAuthorRequest {}
+message QueryGetConfigRequest {}
+message QueryCountPostsRequest {}
+`
+	testTxProto = `syntax = "proto3";
+package mars.mars.v1;
+
+message MsgLikePost {}
+`
+)
+
+func TestExists(t *testing.T) {
+	appPath := t.TempDir()
+	protoPath := filepath.Join(appPath, "proto", "mars", "mars", "v1")
+	require.NoError(t, os.MkdirAll(protoPath, 0o755))
+	for name, content := range map[string]string{
+		"genesis.proto": testGenesisProto,
+		"query.proto":   testQueryProto,
+		"tx.proto":      testTxProto,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(protoPath, name), []byte(content), 0o644))
+	}
+
+	tests := []struct {
+		name      string
+		kind      Kind
+		component string
+		want      bool
+		err       error
+	}{
+		{name: "list", kind: KindList, component: "post", want: true},
+		{name: "map scaffolded as list", kind: KindMap, component: "post", want: false},
+		{name: "map", kind: KindMap, component: "author", want: true},
+		{name: "list scaffolded as map", kind: KindList, component: "author", want: false},
+		{name: "single", kind: KindSingle, component: "config", want: true},
+		{name: "single scaffolded as list", kind: KindSingle, component: "post", want: false},
+		{name: "message", kind: KindMessage, component: "like-post", want: true},
+		{name: "query", kind: KindQuery, component: "count-posts", want: true},
+		{name: "missing query", kind: KindQuery, component: "foo", want: false},
+		{name: "packet without packet proto", kind: KindPacket, component: "foo", want: false},
+		{name: "unknown kind", kind: Kind("foo"), component: "foo", err: errUnknownKind},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, err := multiformatname.NewName(tt.component)
+			require.NoError(t, err)
+
+			got, err := Exists(&Options{
+				AppName:    "mars",
+				AppPath:    appPath,
+				ProtoDir:   "proto",
+				ProtoVer:   "v1",
+				ModuleName: "mars",
+				Kind:       tt.kind,
+				Name:       name,
+			})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFiles(t *testing.T) {
+	tests := []struct {
+		name      string
+		kind      Kind
+		component string
+		files     []string
+		want      []string
+	}{
+		{
+			name:      "message",
+			kind:      KindMessage,
+			component: "like-post",
+			files: []string{
+				"keeper/msg_server_like_post.go",
+				"keeper/msg_server_like_post_fuzz_test.go",
+				"types/message_like_post.go",
+				"simulation/like_post.go",
+				"integration/bench_like_post_test.go",
+				"integration/integration_test.go",
+				"keeper/msg_server_other.go",
+			},
+			want: []string{
+				"keeper/msg_server_like_post.go",
+				"keeper/msg_server_like_post_fuzz_test.go",
+				"types/message_like_post.go",
+				"simulation/like_post.go",
+				"integration/bench_like_post_test.go",
+			},
+		},
+		{
+			name:      "message without fuzz test",
+			kind:      KindMessage,
+			component: "like-post",
+			files:     []string{"keeper/msg_server_like_post.go", "types/message_like_post.go"},
+			want:      []string{"keeper/msg_server_like_post.go", "types/message_like_post.go"},
+		},
+		{
+			name:      "query",
+			kind:      KindQuery,
+			component: "count-posts",
+			files:     []string{"keeper/query_count_posts.go", "keeper/query_count_posts_test.go"},
+			want:      []string{"keeper/query_count_posts.go", "keeper/query_count_posts_test.go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, err := multiformatname.NewName(tt.component)
+			require.NoError(t, err)
+
+			opts := &Options{
+				AppName:    "mars",
+				AppPath:    t.TempDir(),
+				ProtoDir:   "proto",
+				ProtoVer:   "v1",
+				ModuleName: "mars",
+				Kind:       tt.kind,
+				Name:       name,
+			}
+			for _, file := range tt.files {
+				path := opts.ModuleFile(file)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+				require.NoError(t, os.WriteFile(path, []byte("package mars\n"), 0o644))
+			}
+
+			want := make([]string, len(tt.want))
+			for i, file := range tt.want {
+				want[i] = opts.ModuleFile(file)
+			}
+
+			got, err := Files(opts)
+			require.NoError(t, err)
+			require.ElementsMatch(t, want, got)
+		})
+	}
+}
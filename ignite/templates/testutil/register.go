@@ -2,17 +2,39 @@ package testutil
 
 import (
 	"embed"
+	"os"
+	"path/filepath"
 
 	"github.com/gobuffalo/genny/v2"
 
 	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
 )
 
-//go:embed files/* files/**/*
-var fs embed.FS
+var (
+	//go:embed files/* files/**/*
+	fs embed.FS
+
+	//go:embed files-integration/* files-integration/**/*
+	fsIntegration embed.FS
+)
 
 // Register testutil template using existing generator.
 // Register is meant to be used by modules that depend on this module.
 func Register(gen *genny.Generator, appPath string) error {
 	return xgenny.Box(gen, xgenny.NewEmbedWalker(fs, "files/", appPath))
 }
+
+// PathIntegration is the path of the integration test harness within the app.
+const PathIntegration = "testutil/integration/integration.go"
+
+// HasIntegration returns true if the app has the integration test harness.
+func HasIntegration(appPath string) bool {
+	_, err := os.Stat(filepath.Join(appPath, PathIntegration))
+	return err == nil
+}
+
+// RegisterIntegration registers the integration test harness template using existing generator.
+// The harness boots the app in-process and requires the "modulePath" variable in the plush context.
+func RegisterIntegration(gen *genny.Generator, appPath string) error {
+	return xgenny.Box(gen, xgenny.NewEmbedWalker(fsIntegration, "files-integration/", appPath))
+}
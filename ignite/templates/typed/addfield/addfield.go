@@ -0,0 +1,282 @@
+package addfield
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+// Options ...
+type Options struct {
+	AppName    string
+	AppPath    string
+	ProtoDir   string
+	ProtoVer   string
+	ModuleName string
+	TypeName   multiformatname.Name
+	Fields     field.Fields
+	NoMessage  bool
+	Singleton  bool
+}
+
+// ProtoFile returns the path to the proto folder within the generated app.
+func (opts *Options) ProtoFile(fname string) string {
+	return filepath.Join(opts.AppPath, opts.ProtoDir, opts.AppName, opts.ModuleName, opts.ProtoVer, fname)
+}
+
+// ModuleFile returns the path to a file of the module within the generated app.
+func (opts *Options) ModuleFile(fname string) string {
+	return filepath.Join(opts.AppPath, "x", opts.ModuleName, fname)
+}
+
+// NewGenerator returns the generator to add new fields to a type scaffolded
+// with the list, map or single commands.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(protoTypeModify(opts))
+
+	if !opts.NoMessage {
+		g.RunFn(protoTxModify(opts))
+		g.RunFn(typesMessagesModify(opts))
+		g.RunFn(keeperMsgServerModify(opts))
+		g.RunFn(clientCliTxModify(opts))
+	}
+
+	if opts.Singleton {
+		g.RunFn(genesisTestsModify(opts, "keeper/genesis_test.go", "TestGenesis"))
+		g.RunFn(genesisTestsModify(opts, "types/genesis_test.go", "TestGenesisState_Validate"))
+	}
+
+	return g, nil
+}
+
+// protoTypeModify modifies the proto file of the type to add the new fields.
+func protoTypeModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.ProtoFile(opts.TypeName.Snake + ".proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		protoFile, err := protoutil.ParseProtoFile(f)
+		if err != nil {
+			return err
+		}
+
+		if err := appendProtoFields(protoFile, opts.TypeName.UpperCamel, opts.Fields); err != nil {
+			return errors.Errorf("failed while adding fields in %s: %w", path, err)
+		}
+		if err := addProtoImports(protoFile, opts); err != nil {
+			return errors.Errorf("failed while adding imports in %s: %w", path, err)
+		}
+
+		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+		return r.File(newFile)
+	}
+}
+
+// protoTxModify modifies the tx.proto file to add the new fields to the create and update messages.
+func protoTxModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.ProtoFile("tx.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		protoFile, err := protoutil.ParseProtoFile(f)
+		if err != nil {
+			return err
+		}
+
+		for _, msgName := range []string{
+			fmt.Sprintf("MsgCreate%s", opts.TypeName.UpperCamel),
+			fmt.Sprintf("MsgUpdate%s", opts.TypeName.UpperCamel),
+		} {
+			if err := appendProtoFields(protoFile, msgName, opts.Fields); err != nil {
+				return errors.Errorf("failed while adding fields in %s: %w", path, err)
+			}
+		}
+		if err := addProtoImports(protoFile, opts); err != nil {
+			return errors.Errorf("failed while adding imports in %s: %w", path, err)
+		}
+
+		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+		return r.File(newFile)
+	}
+}
+
+// typesMessagesModify modifies the message constructors to add the new fields.
+func typesMessagesModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.ModuleFile(fmt.Sprintf("types/messages_%s.go", opts.TypeName.Snake))
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, msgName := range []string{
+			fmt.Sprintf("MsgCreate%s", opts.TypeName.UpperCamel),
+			fmt.Sprintf("MsgUpdate%s", opts.TypeName.UpperCamel),
+		} {
+			options := make([]xast.FunctionOptions, 0, len(opts.Fields)*2)
+			for _, field := range opts.Fields {
+				options = append(options,
+					xast.AppendFuncParams(field.Name.LowerCamel, field.DataType(), -1),
+					xast.AppendFuncStruct(msgName, field.Name.UpperCamel, field.Name.LowerCamel, -1),
+				)
+			}
+			content, err = xast.ModifyFunction(content, "New"+msgName, options...)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperMsgServerModify modifies the create and update message handlers to store the new fields.
+func keeperMsgServerModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.ModuleFile(fmt.Sprintf("keeper/msg_server_%s.go", opts.TypeName.Snake))
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		options := make([]xast.FunctionOptions, 0, len(opts.Fields))
+		for _, field := range opts.Fields {
+			options = append(options, xast.AppendFuncStruct(
+				opts.TypeName.UpperCamel,
+				field.Name.UpperCamel,
+				fmt.Sprintf("msg.%s", field.Name.UpperCamel),
+				-1,
+			))
+		}
+
+		content := f.String()
+		for _, funcName := range []string{
+			fmt.Sprintf("Create%s", opts.TypeName.UpperCamel),
+			fmt.Sprintf("Update%s", opts.TypeName.UpperCamel),
+		} {
+			content, err = xast.ModifyFunction(content, funcName, options...)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// clientCliTxModify modifies the autocli options of the create and update messages
+// to add the new fields as positional arguments.
+func clientCliTxModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.ModuleFile("module/autocli.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		options := make([]xast.LiteralOptions, 0, len(opts.Fields)*2)
+		for _, field := range opts.Fields {
+			options = append(options,
+				xast.AppendLiteralSliceValue("PositionalArgs", fmt.Sprintf(`{ProtoField: "%s"}`, field.ProtoFieldName())),
+				xast.AppendLiteralStringValue("Use", fmt.Sprintf(" [%s]", field.ProtoFieldName())),
+			)
+		}
+
+		content := f.String()
+		for _, rpcName := range []string{
+			fmt.Sprintf("Create%s", opts.TypeName.UpperCamel),
+			fmt.Sprintf("Update%s", opts.TypeName.UpperCamel),
+		} {
+			content, err = xast.ModifyLiteral(content, "RpcMethod", rpcName, options...)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// genesisTestsModify modifies the sample value of a singleton in the genesis tests
+// to set the new fields.
+func genesisTestsModify(opts *Options, fname, funcName string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.ModuleFile(fname)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		options := make([]xast.FunctionOptions, 0, len(opts.Fields))
+		for i, field := range opts.Fields {
+			// the sample values are derived from the field index to keep the tests deterministic,
+			// genesis args are formatted as "Name: value,\n".
+			arg := strings.TrimSuffix(strings.TrimSpace(field.GenesisArgs(i+1)), ",")
+			name, value, ok := strings.Cut(arg, ":")
+			if !ok {
+				return errors.Errorf("invalid genesis arg %s for field %s", arg, field.Name.Original)
+			}
+			options = append(options, xast.AppendFuncStruct(
+				opts.TypeName.UpperCamel,
+				strings.TrimSpace(name),
+				strings.TrimSpace(value),
+				-1,
+			))
+		}
+
+		content, err := xast.ModifyFunction(f.String(), funcName, options...)
+		if err != nil {
+			return err
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// appendProtoFields appends the fields to the message using the next free field numbers.
+func appendProtoFields(protoFile *proto.Proto, msgName string, fields field.Fields) error {
+	msg, err := protoutil.GetMessageByName(protoFile, msgName)
+	if err != nil {
+		return errors.Errorf("failed while looking up message '%s': %w", msgName, err)
+	}
+	for _, field := range fields {
+		if protoutil.HasField(protoFile, msgName, field.ProtoFieldName()) {
+			return errors.Errorf("field %s already exists in message %s", field.ProtoFieldName(), msgName)
+		}
+		protoutil.Append(msg, field.ToProtoField(protoutil.NextUniqueID(msg)))
+	}
+	return nil
+}
+
+// addProtoImports ensures the imports required by the field types exist.
+func addProtoImports(protoFile *proto.Proto, opts *Options) error {
+	var protoImports []*proto.Import
+	for _, imp := range opts.Fields.ProtoImports() {
+		protoImports = append(protoImports, protoutil.NewImport(imp))
+	}
+	for _, f := range opts.Fields.Custom() {
+		protoPath := fmt.Sprintf("%[1]v/%[2]v/%[3]v/%[4]v.proto", opts.AppName, opts.ModuleName, opts.ProtoVer, f)
+		protoImports = append(protoImports, protoutil.NewImport(protoPath))
+	}
+	return protoutil.AddImports(protoFile, true, protoImports...)
+}
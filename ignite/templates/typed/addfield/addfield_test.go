@@ -0,0 +1,288 @@
+package addfield
+
+import (
+	"context"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+const (
+	testPostProto = `syntax = "proto3";
+package mars.mars.v1;
+
+message Post {
+  uint64 id = 1;
+  string title = 2;
+  string creator = 3;
+}
+`
+	testTxProto = `syntax = "proto3";
+package mars.mars.v1;
+
+message MsgCreatePost {
+  string creator = 1;
+  string title = 2;
+}
+
+message MsgUpdatePost {
+  string creator = 1;
+  uint64 id = 2;
+  string title = 3;
+}
+`
+	testMessages = `package types
+
+func NewMsgCreatePost(creator string, title string) *MsgCreatePost {
+	return &MsgCreatePost{
+		Creator: creator,
+		Title:   title,
+	}
+}
+
+func NewMsgUpdatePost(creator string, id uint64, title string) *MsgUpdatePost {
+	return &MsgUpdatePost{
+		Id:      id,
+		Creator: creator,
+		Title:   title,
+	}
+}
+`
+	testMsgServer = `package keeper
+
+func (k msgServer) CreatePost(ctx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	var post = types.Post{
+		Creator: msg.Creator,
+		Title:   msg.Title,
+	}
+	return &types.MsgCreatePostResponse{}, k.Post.Set(ctx, post)
+}
+
+func (k msgServer) UpdatePost(ctx context.Context, msg *types.MsgUpdatePost) (*types.MsgUpdatePostResponse, error) {
+	var post = types.Post{
+		Creator: msg.Creator,
+		Id:      msg.Id,
+		Title:   msg.Title,
+	}
+	return &types.MsgUpdatePostResponse{}, k.Post.Set(ctx, post)
+}
+`
+	testAutoCLI = `package mars
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreatePost",
+					Use:            "create-post [title]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}},
+				},
+				{
+					RpcMethod:      "UpdatePost",
+					Use:            "update-post [id] [title]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "title"}},
+				},
+			},
+		},
+	}
+}
+`
+	testKeeperGenesis = `package keeper_test
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Config: &types.Config{
+			Title: "85",
+		},
+	}
+	require.NotNil(t, genesisState)
+}
+`
+	testTypesGenesis = `package types_test
+
+func TestGenesisState_Validate(t *testing.T) {
+	genesisState := types.GenesisState{
+		Config: &types.Config{
+			Title: "33",
+		},
+	}
+	require.NotNil(t, genesisState)
+}
+`
+)
+
+// writeTestApp writes the files of a module scaffolded with a type in a new app.
+func writeTestApp(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	appPath := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(appPath, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	return appPath
+}
+
+// runGenerator runs the generator adding the fields to the type and returns the content of the app files.
+func runGenerator(t *testing.T, opts *Options) map[string]string {
+	t.Helper()
+
+	g, err := NewGenerator(opts)
+	require.NoError(t, err)
+
+	runner := xgenny.NewRunner(context.Background(), opts.AppPath)
+	_, err = runner.RunAndApply(g)
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	err = filepath.WalkDir(opts.AppPath, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(opts.AppPath, path)
+		if err != nil {
+			return err
+		}
+		// the Go files are formatted after the scaffolding
+		if filepath.Ext(path) == ".go" {
+			if content, err = format.Source(content); err != nil {
+				return err
+			}
+		}
+		files[filepath.ToSlash(relPath)] = string(content)
+		return nil
+	})
+	require.NoError(t, err)
+	return files
+}
+
+// compact replaces the spaces of the content with single spaces, as the proto fields are aligned.
+func compact(content string) string {
+	return strings.Join(strings.Fields(content), " ")
+}
+
+func TestNewGenerator(t *testing.T) {
+	appPath := writeTestApp(t, map[string]string{
+		"proto/mars/mars/v1/post.proto":    testPostProto,
+		"proto/mars/mars/v1/tx.proto":      testTxProto,
+		"x/mars/types/messages_post.go":    testMessages,
+		"x/mars/keeper/msg_server_post.go": testMsgServer,
+		"x/mars/module/autocli.go":         testAutoCLI,
+	})
+
+	typeName, err := multiformatname.NewName("post")
+	require.NoError(t, err)
+	fields, err := field.ParseFields([]string{"body", "likes:uint"}, func(string) error { return nil })
+	require.NoError(t, err)
+
+	files := runGenerator(t, &Options{
+		AppName:    "mars",
+		AppPath:    appPath,
+		ProtoDir:   "proto",
+		ProtoVer:   "v1",
+		ModuleName: "mars",
+		TypeName:   typeName,
+		Fields:     fields,
+	})
+
+	postProto := compact(files["proto/mars/mars/v1/post.proto"])
+	require.Contains(t, postProto, "string body = 4;")
+	require.Contains(t, postProto, "uint64 likes = 5;")
+
+	txProto := compact(files["proto/mars/mars/v1/tx.proto"])
+	require.Contains(t, txProto, "string body = 3;")
+	require.Contains(t, txProto, "uint64 likes = 4;")
+	require.Contains(t, txProto, "string body = 4;")
+	require.Contains(t, txProto, "uint64 likes = 5;")
+
+	messages := files["x/mars/types/messages_post.go"]
+	require.Contains(t, messages, "func NewMsgCreatePost(creator string, title string, body string, likes uint64)")
+	require.Contains(t, messages, "func NewMsgUpdatePost(creator string, id uint64, title string, body string, likes uint64)")
+	require.Contains(t, messages, "Body:")
+	require.Contains(t, messages, "Likes:")
+
+	msgServer := files["x/mars/keeper/msg_server_post.go"]
+	require.Contains(t, msgServer, "msg.Body")
+	require.Contains(t, msgServer, "msg.Likes")
+
+	autoCLI := files["x/mars/module/autocli.go"]
+	require.Contains(t, autoCLI, `"create-post [title] [body] [likes]"`)
+	require.Contains(t, autoCLI, `"update-post [id] [title] [body] [likes]"`)
+	require.Contains(t, autoCLI, `{ProtoField: "body"}`)
+	require.Contains(t, autoCLI, `{ProtoField: "likes"}`)
+}
+
+func TestNewGeneratorExistingField(t *testing.T) {
+	appPath := writeTestApp(t, map[string]string{
+		"proto/mars/mars/v1/post.proto": testPostProto,
+	})
+
+	typeName, err := multiformatname.NewName("post")
+	require.NoError(t, err)
+	fields, err := field.ParseFields([]string{"title"}, func(string) error { return nil })
+	require.NoError(t, err)
+
+	g, err := NewGenerator(&Options{
+		AppName:    "mars",
+		AppPath:    appPath,
+		ProtoDir:   "proto",
+		ProtoVer:   "v1",
+		ModuleName: "mars",
+		TypeName:   typeName,
+		Fields:     fields,
+		NoMessage:  true,
+	})
+	require.NoError(t, err)
+
+	err = xgenny.NewRunner(context.Background(), appPath).Run(g)
+	require.ErrorContains(t, err, "field title already exists in message Post")
+}
+
+func TestNewGeneratorSingleton(t *testing.T) {
+	typeName, err := multiformatname.NewName("config")
+	require.NoError(t, err)
+	fields, err := field.ParseFields([]string{"body", "likes:uint"}, func(string) error { return nil })
+	require.NoError(t, err)
+
+	// the genesis tests are the same for every run as the sample values are deterministic
+	var got []map[string]string
+	for i := 0; i < 2; i++ {
+		appPath := writeTestApp(t, map[string]string{
+			"proto/mars/mars/v1/config.proto": "syntax = \"proto3\";\npackage mars.mars.v1;\n\nmessage Config {\n  string title = 1;\n}\n",
+			"x/mars/keeper/genesis_test.go":   testKeeperGenesis,
+			"x/mars/types/genesis_test.go":    testTypesGenesis,
+		})
+
+		got = append(got, runGenerator(t, &Options{
+			AppName:    "mars",
+			AppPath:    appPath,
+			ProtoDir:   "proto",
+			ProtoVer:   "v1",
+			ModuleName: "mars",
+			TypeName:   typeName,
+			Fields:     fields,
+			NoMessage:  true,
+			Singleton:  true,
+		}))
+	}
+	require.Equal(t, got[0], got[1])
+
+	for _, name := range []string{"x/mars/keeper/genesis_test.go", "x/mars/types/genesis_test.go"} {
+		require.Contains(t, got[0][name], `Body: "1"`)
+		require.Contains(t, got[0][name], "Likes: 2")
+	}
+}
@@ -0,0 +1,361 @@
+package wasm
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+	"golang.org/x/mod/modfile"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/placeholder"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/module"
+)
+
+const (
+	// WasmdModule is the Go module of the wasm module.
+	WasmdModule = "github.com/CosmWasm/wasmd"
+
+	// WasmdVersion is the version of the wasm module added to the app, it must target the
+	// Cosmos SDK and the ibc-go versions of the app template.
+	WasmdVersion = "v0.55.0"
+
+	// DefaultQueryGasLimit is the default maximum gas used by the smart queries of the contracts.
+	DefaultQueryGasLimit uint64 = 3_000_000
+
+	// DefaultMemoryCacheSize is the default size of the in-memory cache of the contracts, in MiB.
+	DefaultMemoryCacheSize uint32 = 100
+
+	// DefaultSimulationGasLimit is the default maximum gas used by the transaction simulations.
+	DefaultSimulationGasLimit uint64 = 50_000_000
+)
+
+//go:embed files/* files/**/*
+var files embed.FS
+
+// Options are options to add the wasm module to an app.
+type Options struct {
+	AppPath    string
+	CmdPath    string
+	ConfigPath string
+
+	QueryGasLimit      uint64
+	MemoryCacheSize    uint32
+	SimulationGasLimit uint64
+}
+
+// NewGenerator returns the generator to add the wasm module to an app.
+func NewGenerator(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(appModify(replacer, opts))
+	g.RunFn(appConfigModify(replacer, opts))
+	g.RunFn(ibcModify(replacer, opts))
+	g.RunFn(cmdModify(opts))
+	g.RunFn(goModModify(opts))
+	g.RunFn(configModify(opts))
+
+	if err := g.Box(xgenny.NewEmbedWalker(files, "files/", opts.AppPath)); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	g.Transformer(xgenny.Transformer(ctx))
+
+	return g, nil
+}
+
+// app.go modification when adding the wasm module.
+func appModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import
+		content, err := xast.AppendImports(
+			f.String(),
+			xast.WithLastNamedImport("wasmkeeper", "github.com/CosmWasm/wasmd/x/wasm/keeper"),
+			xast.WithLastNamedImport("feegrantkeeper", "cosmossdk.io/x/feegrant/keeper"),
+		)
+		if err != nil {
+			return err
+		}
+
+		// Keeper declarations, the ante handler of the wasm module requires the feegrant keeper
+		template := `FeeGrantKeeper feegrantkeeper.Keeper
+WasmKeeper wasmkeeper.Keeper
+
+%[1]v`
+		replacement := fmt.Sprintf(template, module.PlaceholderSgAppKeeperDeclaration)
+		content = replacer.Replace(content, module.PlaceholderSgAppKeeperDeclaration, replacement)
+
+		// Keeper definition and initialization of the pinned codes once the app is loaded
+		content, err = xast.ModifyFunction(
+			content,
+			"New",
+			xast.AppendInsideFuncCall("Inject", "\n&app.FeeGrantKeeper", -1),
+			xast.AppendFuncCode(`if err := app.initializeWasmPinnedCodes(loadLatest); err != nil {
+	panic(err)
+}`),
+		)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
+
+// app_config.go modification when adding the wasm module.
+func appConfigModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppConfigGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import
+		content, err := xast.AppendImports(
+			f.String(),
+			xast.WithLastNamedImport("wasmtypes", "github.com/CosmWasm/wasmd/x/wasm/types"),
+		)
+		if err != nil {
+			return err
+		}
+
+		// Genesis and blockers order
+		template := `wasmtypes.ModuleName,
+%[1]v`
+		for _, p := range []string{
+			module.PlaceholderSgAppInitGenesis,
+			module.PlaceholderSgAppBeginBlockers,
+			module.PlaceholderSgAppEndBlockers,
+		} {
+			content = replacer.Replace(content, p, fmt.Sprintf(template, p))
+		}
+
+		// Module account permissions
+		template = `{Account: wasmtypes.ModuleName, Permissions: []string{authtypes.Burner}},
+%[1]v`
+		replacement := fmt.Sprintf(template, module.PlaceholderSgAppMaccPerms)
+		content = replacer.Replace(content, module.PlaceholderSgAppMaccPerms, replacement)
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
+
+// ibc.go modification when adding the wasm module.
+func ibcModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathIBCConfigGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import
+		content, err := xast.AppendImports(
+			f.String(),
+			xast.WithImport("maps", 0),
+			xast.WithLastNamedImport("wasmtypes", "github.com/CosmWasm/wasmd/x/wasm/types"),
+		)
+		if err != nil {
+			return err
+		}
+
+		// Register the wasm module and add its IBC route
+		template := `wasmIBCModule, err := app.registerWasmModules(appOpts)
+if err != nil {
+	return err
+}
+ibcRouter.AddRoute(wasmtypes.ModuleName, wasmIBCModule)
+
+%[1]v`
+		replacement := fmt.Sprintf(template, module.PlaceholderIBCNewModule)
+		content = replacer.Replace(content, module.PlaceholderIBCNewModule, replacement)
+
+		// Register the wasm module on the client side
+		content, err = xast.ModifyFunction(
+			content,
+			"RegisterIBC",
+			xast.AppendFuncAtLine("maps.Copy(modules, registerWasmClient(cdc))", 1),
+		)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
+
+// cmd/commands.go modification to add the wasm flags to the start command.
+func cmdModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.CmdPath, "cmd/commands.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import
+		content, err := xast.AppendImports(
+			f.String(),
+			xast.WithLastImport("github.com/CosmWasm/wasmd/x/wasm"),
+		)
+		if err != nil {
+			return err
+		}
+
+		content, err = addStartCmdWasmFlags(content)
+		if err != nil {
+			return errors.Errorf("%s: %w", path, err)
+		}
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
+
+// addStartCmdWasmFlags sets the wasm flags as the flags added to the start command
+// by the options of the start command created in the initRootCmd function.
+func addStartCmdWasmFlags(content string) (string, error) {
+	const addFlags = "wasm.AddModuleInitFlags"
+	if strings.Contains(content, addFlags) {
+		return content, nil
+	}
+	return xast.ModifyFunction(
+		content,
+		"initRootCmd",
+		xast.AppendFuncStruct("StartCmdOptions", "AddFlags", addFlags, -1),
+	)
+}
+
+// go.mod modification to require the wasm module.
+func goModModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "go.mod")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		gomod, err := modfile.Parse(path, []byte(f.String()), nil)
+		if err != nil {
+			return err
+		}
+		if err := gomod.AddRequire(WasmdModule, WasmdVersion); err != nil {
+			return err
+		}
+		gomod.Cleanup()
+
+		content, err := gomod.Format()
+		if err != nil {
+			return err
+		}
+		return r.File(genny.NewFileS(path, string(content)))
+	}
+}
+
+// config.yml modification to add the wasm node settings to the validators
+// and the section of the contracts uploaded by chain serve.
+func configModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(opts.ConfigPath)
+		if err != nil {
+			return err
+		}
+
+		var doc yaml.Node
+		if err := yaml.Unmarshal([]byte(f.String()), &doc); err != nil {
+			return err
+		}
+		if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+			return errors.Errorf("invalid config %s", opts.ConfigPath)
+		}
+		root := doc.Content[0]
+
+		// The node settings are written to the app.toml of the validators
+		if validators := mappingValue(root, "validators"); validators != nil {
+			for _, validator := range validators.Content {
+				app := mappingValue(validator, "app")
+				if app == nil {
+					app = &yaml.Node{Kind: yaml.MappingNode}
+					appendMapping(validator, "app", app)
+				}
+				if mappingValue(app, "wasm") == nil {
+					appendMapping(app, "wasm", mappingNode(
+						"query_gas_limit", strconv.FormatUint(opts.QueryGasLimit, 10),
+						"memory_cache_size", strconv.FormatUint(uint64(opts.MemoryCacheSize), 10),
+						"simulation_gas_limit", strconv.FormatUint(opts.SimulationGasLimit, 10),
+					))
+				}
+			}
+		}
+
+		if mappingValue(root, "wasm") == nil {
+			contracts := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+			wasm := &yaml.Node{Kind: yaml.MappingNode}
+			appendMapping(wasm, "contracts", contracts)
+			appendMapping(root, "wasm", wasm)
+
+			// Document the contracts with an example as the list is empty
+			root.Content[len(root.Content)-2].HeadComment = `The contracts are uploaded and instantiated by "ignite chain serve", e.g.:
+ contracts:
+ - name: counter
+   path: artifacts/counter.wasm
+   signer: alice
+   instantiate:
+     msg:
+       count: 0`
+		}
+
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(&doc); err != nil {
+			return err
+		}
+		if err := enc.Close(); err != nil {
+			return err
+		}
+		return r.File(genny.NewFileS(opts.ConfigPath, buf.String()))
+	}
+}
+
+// mappingValue returns the value of the key of the YAML mapping, nil when the key doesn't exist.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// appendMapping appends the key and its value to the YAML mapping.
+func appendMapping(node *yaml.Node, key string, value *yaml.Node) {
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
+
+// mappingNode returns a YAML mapping of the key and value pairs, the values are integers.
+func mappingNode(pairs ...string) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		appendMapping(node, pairs[i], &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: pairs[i+1]})
+	}
+	return node
+}
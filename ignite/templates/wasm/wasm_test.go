@@ -0,0 +1,89 @@
+package wasm
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddStartCmdWasmFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		err     string
+	}{
+		{
+			name: "empty options",
+			content: `package cmd
+
+func initRootCmd(rootCmd *cobra.Command) {
+	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{})
+}
+`,
+			want: `package cmd
+
+func initRootCmd(rootCmd *cobra.Command) {
+	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{AddFlags: wasm.AddModuleInitFlags})
+}
+`,
+		},
+		{
+			name: "existing options",
+			content: `package cmd
+
+func initRootCmd(rootCmd *cobra.Command) {
+	opts := server.StartCmdOptions{DBOpener: openDB}
+	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, opts)
+}
+`,
+			want: `package cmd
+
+func initRootCmd(rootCmd *cobra.Command) {
+	opts := server.StartCmdOptions{DBOpener: openDB, AddFlags: wasm.AddModuleInitFlags}
+	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, opts)
+}
+`,
+		},
+		{
+			name: "flags already added",
+			content: `package cmd
+
+func initRootCmd(rootCmd *cobra.Command) {
+	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{AddFlags: wasm.AddModuleInitFlags})
+}
+`,
+			want: `package cmd
+
+func initRootCmd(rootCmd *cobra.Command) {
+	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{AddFlags: wasm.AddModuleInitFlags})
+}
+`,
+		},
+		{
+			name: "no start command options",
+			content: `package cmd
+
+func initRootCmd(rootCmd *cobra.Command) {
+	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, appExport)
+}
+`,
+			err: "function structs not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addStartCmdWasmFlags(tt.content)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			formatted, err := format.Source([]byte(got))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(formatted))
+		})
+	}
+}
@@ -203,3 +203,45 @@ func TestGenerateAppWithEmptyModule(t *testing.T) {
 
 	app.EnsureSteady()
 }
+
+func TestGenerateAppWithIntegrationTests(t *testing.T) {
+	var (
+		env = envtest.New(t)
+		app = env.Scaffold("github.com/test/blog")
+	)
+
+	env.Must(env.Exec("create a module with integration tests",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "module", "--yes", "example", "--with-integration-tests", "--require-registration"),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("create a module with params and integration tests",
+		step.NewSteps(step.New(
+			step.Exec(
+				envtest.IgniteApp,
+				"s",
+				"module",
+				"--yes",
+				"with_params",
+				"--params",
+				"foo:uint,bar:bool",
+				"--with-integration-tests",
+				"--require-registration",
+			),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	for _, dir := range []string{
+		filepath.Join("testutil", "integration"),
+		filepath.Join("x", "example", "integration"),
+		filepath.Join("x", "withparams", "integration"),
+	} {
+		_, statErr := os.Stat(filepath.Join(app.SourcePath(), dir))
+		require.False(t, os.IsNotExist(statErr), "%s should be scaffolded", dir)
+	}
+
+	app.EnsureSteady()
+}
@@ -0,0 +1,74 @@
+//go:build !relayer
+
+package wasm_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+	envtest "github.com/ignite/cli/v29/integration"
+)
+
+func TestScaffoldWasm(t *testing.T) {
+	var (
+		env = envtest.New(t)
+		app = env.Scaffold("github.com/test/blog")
+	)
+
+	env.Must(env.Exec("add the wasm module",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "wasm", "--yes"),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent adding the wasm module twice",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "wasm", "--yes"),
+			step.Workdir(app.SourcePath()),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	for file, want := range map[string]string{
+		"go.mod":                    "github.com/CosmWasm/wasmd",
+		"app/app.go":                "WasmKeeper",
+		"cmd/blogd/cmd/commands.go": "wasm.AddModuleInitFlags",
+		"config.yml":                "query_gas_limit",
+	} {
+		content, err := os.ReadFile(filepath.Join(app.SourcePath(), file))
+		require.NoError(t, err)
+		require.Contains(t, string(content), want, file)
+	}
+
+	env.Must(env.Exec("build the app with the wasm module",
+		step.NewSteps(step.New(
+			step.Exec(gocmd.Name(), "build", "./..."),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	app.EnsureSteady()
+}
+
+func TestScaffoldWasmMinimal(t *testing.T) {
+	var (
+		env = envtest.New(t)
+		app = env.Scaffold("github.com/test/blog", "--minimal")
+	)
+
+	env.Must(env.Exec("should prevent adding the wasm module to a minimal chain",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "wasm", "--yes"),
+			step.Workdir(app.SourcePath()),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	app.EnsureSteady()
+}